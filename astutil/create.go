@@ -4,6 +4,7 @@ import (
 	"go/ast"
 	"go/token"
 	"go/types"
+	"reflect"
 )
 
 // CreateNoopOfStatement creates a syntactically safe noop statement out of a given statement.
@@ -35,3 +36,88 @@ func CreateNoopOfStatements(pkg *types.Package, info *types.Info, stmts []ast.St
 		Tok: token.ASSIGN,
 	}
 }
+
+// CreateNoopOfExprs creates a noop statement which assigns copies of the given expressions to blank identifiers, so their variables stay used.
+// Untyped nil expressions are left out since they can not be assigned.
+func CreateNoopOfExprs(info *types.Info, exprs []ast.Expr) ast.Stmt {
+	var lhs, rhs []ast.Expr
+	for _, x := range exprs {
+		if info != nil && info.Types[x].IsNil() {
+			continue
+		}
+
+		lhs = append(lhs, ast.NewIdent("_"))
+		rhs = append(rhs, CloneExpr(x))
+	}
+
+	if len(rhs) == 0 {
+		return &ast.EmptyStmt{
+			Semicolon: token.NoPos,
+		}
+	}
+
+	return &ast.AssignStmt{
+		Lhs: lhs,
+		Rhs: rhs,
+		Tok: token.ASSIGN,
+	}
+}
+
+// CloneExpr returns a deep copy of the given expression without positions, so the copy can be printed at another place of the source code.
+func CloneExpr(expr ast.Expr) ast.Expr {
+	return clone(reflect.ValueOf(expr)).Interface().(ast.Expr)
+}
+
+var (
+	posType    = reflect.TypeOf(token.NoPos)
+	objectType = reflect.TypeOf(&ast.Object{})
+	scopeType  = reflect.TypeOf(&ast.Scope{})
+)
+
+func clone(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+
+		c := reflect.New(v.Type()).Elem()
+		c.Set(clone(v.Elem()))
+
+		return c
+	case reflect.Ptr:
+		// Objects and scopes are shared since they are resolved by the parser and not printed
+		if v.IsNil() || v.Type() == objectType || v.Type() == scopeType {
+			return v
+		}
+
+		c := reflect.New(v.Type().Elem())
+		c.Elem().Set(clone(v.Elem()))
+
+		return c
+	case reflect.Struct:
+		c := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).Type == posType {
+				continue
+			}
+
+			c.Field(i).Set(clone(v.Field(i)))
+		}
+
+		return c
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(clone(v.Index(i)))
+		}
+
+		return c
+	}
+
+	return v
+}
@@ -118,3 +118,167 @@ func (w *functionWalker) Visit(node ast.Node) ast.Visitor {
 
 	return w
 }
+
+// FuncDeclAt returns the function declaration which contains the given line and the name of its receiver type if it is a method.
+func FuncDeclAt(fset *token.FileSet, file *ast.File, line int) (*ast.FuncDecl, string) {
+	for _, decl := range file.Decls {
+		f, ok := decl.(*ast.FuncDecl)
+		if !ok || fset.Position(f.Pos()).Line > line || fset.Position(f.End()).Line < line {
+			continue
+		}
+
+		if f.Recv == nil || len(f.Recv.List) == 0 {
+			return f, ""
+		}
+
+		recv := f.Recv.List[0].Type
+		if star, ok := recv.(*ast.StarExpr); ok {
+			recv = star.X
+		}
+		if index, ok := recv.(*ast.IndexExpr); ok {
+			recv = index.X
+		}
+		if index, ok := recv.(*ast.IndexListExpr); ok {
+			recv = index.X
+		}
+		if ident, ok := recv.(*ast.Ident); ok {
+			return f, ident.Name
+		}
+
+		return f, ""
+	}
+
+	return nil, ""
+}
+
+// ValueExprs returns pointers to the child expressions whose values are used by the given node, e.g. the arguments of a call,
+// so a mutator can replace an expression in its parent.
+func ValueExprs(node ast.Node) []*ast.Expr {
+	var xs []*ast.Expr
+
+	switch n := node.(type) {
+	case *ast.CallExpr:
+		for i := range n.Args {
+			xs = append(xs, &n.Args[i])
+		}
+	case *ast.BinaryExpr:
+		xs = append(xs, &n.X, &n.Y)
+	case *ast.ParenExpr:
+		xs = append(xs, &n.X)
+	case *ast.IndexExpr:
+		xs = append(xs, &n.Index)
+	case *ast.SliceExpr:
+		for _, x := range []*ast.Expr{&n.Low, &n.High, &n.Max} {
+			if *x != nil {
+				xs = append(xs, x)
+			}
+		}
+	case *ast.KeyValueExpr:
+		xs = append(xs, &n.Value)
+	case *ast.CompositeLit:
+		for i := range n.Elts {
+			if _, ok := n.Elts[i].(*ast.KeyValueExpr); !ok {
+				xs = append(xs, &n.Elts[i])
+			}
+		}
+	case *ast.AssignStmt:
+		for i := range n.Rhs {
+			xs = append(xs, &n.Rhs[i])
+		}
+	case *ast.ValueSpec:
+		for i := range n.Values {
+			xs = append(xs, &n.Values[i])
+		}
+	case *ast.ReturnStmt:
+		for i := range n.Results {
+			xs = append(xs, &n.Results[i])
+		}
+	}
+
+	return xs
+}
+
+// PackagePath returns the import path if the given expression is an imported package name.
+func PackagePath(info *types.Info, x ast.Expr) string {
+	id, ok := x.(*ast.Ident)
+	if !ok {
+		return ""
+	}
+
+	pkgName, ok := info.Uses[id].(*types.PkgName)
+	if !ok {
+		return ""
+	}
+
+	return pkgName.Imported().Path()
+}
+
+// PackageUsedElsewhere checks if the given package name is used more than once, so the import is still used after a mutation removed one usage.
+func PackageUsedElsewhere(info *types.Info, pkgName *types.PkgName) bool {
+	count := 0
+	for _, obj := range info.Uses {
+		if obj == pkgName {
+			count++
+			if count > 1 {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// RemovableExpr checks if the given expression can be removed without leaving a local variable or an imported package unused,
+// which would not compile.
+func RemovableExpr(info *types.Info, expr ast.Expr) bool {
+	used := map[types.Object]int{}
+	ast.Inspect(expr, func(node ast.Node) bool {
+		id, ok := node.(*ast.Ident)
+		if !ok {
+			return true
+		}
+
+		switch obj := info.Uses[id].(type) {
+		case *types.PkgName:
+			used[obj]++
+		case *types.Var:
+			if isLocalVar(info, obj) {
+				used[obj]++
+			}
+		}
+
+		return true
+	})
+	if len(used) == 0 {
+		return true
+	}
+
+	for _, obj := range info.Uses {
+		if _, ok := used[obj]; ok {
+			used[obj]--
+		}
+	}
+	for _, count := range used {
+		// Every usage of the object is part of the expression
+		if count == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isLocalVar checks if the given variable is declared inside of a function body, unlike fields, parameters and package variables which may be unused.
+func isLocalVar(info *types.Info, v *types.Var) bool {
+	if v.IsField() || v.Pkg() == nil || v.Parent() == nil || v.Parent() == v.Pkg().Scope() {
+		return false
+	}
+
+	for node, scope := range info.Scopes {
+		if ft, ok := node.(*ast.FuncType); ok && scope == v.Parent() && v.Pos() < ft.End() {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,116 @@
+package mutesting
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/VirtualRoyalty/go-mutesting/internal/models"
+)
+
+// buildSystem executes the tests of a mutation with another build system than the go tool.
+type buildSystem struct {
+	command string
+	args    func(opts *models.Options) []string
+	// rootFiles mark the root directory of the workspace of the build system.
+	rootFiles []string
+	// killedExitCodes are exit codes which state that tests failed.
+	killedExitCodes []int
+	// skippedExitCodes are exit codes which state that the build failed, other exit codes are unknown.
+	// If there are none, every exit code besides the success and killed ones means that the build failed.
+	skippedExitCodes []int
+}
+
+var buildSystems = map[string]*buildSystem{
+	"bazel": {
+		command: "bazel",
+		args: func(opts *models.Options) []string {
+			return []string{"test", fmt.Sprintf("--test_timeout=%d", opts.Exec.Timeout)}
+		},
+		rootFiles:        []string{"MODULE.bazel", "WORKSPACE", "WORKSPACE.bazel"},
+		killedExitCodes:  []int{3},
+		skippedExitCodes: []int{1, 4},
+	},
+	"please": {
+		command: "plz",
+		args: func(opts *models.Options) []string {
+			return []string{"test"}
+		},
+		rootFiles:       []string{".plzconfig"},
+		killedExitCodes: []int{7},
+	},
+}
+
+// testCommand returns the command which tests the package of the given file in the workspace of the build system.
+func (b *buildSystem) testCommand(opts *models.Options, file string) (*exec.Cmd, error) {
+	root, err := b.root(file)
+	if err != nil {
+		return nil, err
+	}
+
+	abs, err := filepath.Abs(file)
+	if err != nil {
+		return nil, err
+	}
+	dir, err := filepath.Rel(root, filepath.Dir(abs))
+	if err != nil {
+		return nil, err
+	}
+	if dir == "." {
+		dir = ""
+	}
+
+	target := strings.ReplaceAll(opts.Exec.Target, "{dir}", filepath.ToSlash(dir))
+
+	cmd := exec.Command(b.command, append(b.args(opts), target)...)
+	cmd.Env = os.Environ()
+	cmd.Dir = root
+
+	return cmd, nil
+}
+
+// goTestExitCode maps the exit code of the build system to the exit code of "go test".
+func (b *buildSystem) goTestExitCode(exitCode int) int {
+	if exitCode == 0 {
+		return 0
+	}
+
+	for _, c := range b.killedExitCodes {
+		if exitCode == c {
+			return 1
+		}
+	}
+
+	if len(b.skippedExitCodes) == 0 {
+		return 2
+	}
+	for _, c := range b.skippedExitCodes {
+		if exitCode == c {
+			return 2
+		}
+	}
+
+	return exitCode
+}
+
+// root returns the root directory of the workspace of the given file.
+func (b *buildSystem) root(file string) (string, error) {
+	abs, err := filepath.Abs(file)
+	if err != nil {
+		return "", err
+	}
+
+	for dir := filepath.Dir(abs); ; dir = filepath.Dir(dir) {
+		for _, name := range b.rootFiles {
+			if _, err := os.Stat(filepath.Join(dir, name)); err == nil {
+				return dir, nil
+			}
+		}
+
+		if parent := filepath.Dir(dir); parent == dir {
+			return "", fmt.Errorf("could not find any of %s for %q", strings.Join(b.rootFiles, ", "), file)
+		}
+	}
+}
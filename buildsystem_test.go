@@ -0,0 +1,47 @@
+package mutesting
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/VirtualRoyalty/go-mutesting/internal/models"
+)
+
+func TestBuildSystemTestCommand(t *testing.T) {
+	root := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(root, "MODULE.bazel"), nil, 0666))
+	assert.NoError(t, os.MkdirAll(filepath.Join(root, "pkg", "sub"), 0755))
+
+	opts := &models.Options{}
+	opts.Exec.Timeout = 5
+	opts.Exec.Target = "//{dir}:all"
+
+	cmd, err := buildSystems["bazel"].testCommand(opts, filepath.Join(root, "pkg", "sub", "a.go"))
+	assert.NoError(t, err)
+	assert.Equal(t, root, cmd.Dir)
+	assert.Equal(t, []string{"bazel", "test", "--test_timeout=5", "//pkg/sub:all"}, cmd.Args)
+
+	cmd, err = buildSystems["bazel"].testCommand(opts, filepath.Join(root, "a.go"))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"bazel", "test", "--test_timeout=5", "//:all"}, cmd.Args)
+
+	_, err = buildSystems["please"].testCommand(opts, filepath.Join(root, "a.go"))
+	assert.Error(t, err)
+}
+
+func TestBuildSystemGoTestExitCode(t *testing.T) {
+	bazel := buildSystems["bazel"]
+	assert.Equal(t, 0, bazel.goTestExitCode(0))
+	assert.Equal(t, 1, bazel.goTestExitCode(3))
+	assert.Equal(t, 2, bazel.goTestExitCode(1))
+	assert.Equal(t, 2, bazel.goTestExitCode(4))
+	assert.Equal(t, 37, bazel.goTestExitCode(37))
+
+	please := buildSystems["please"]
+	assert.Equal(t, 0, please.goTestExitCode(0))
+	assert.Equal(t, 1, please.goTestExitCode(7))
+	assert.Equal(t, 2, please.goTestExitCode(1))
+}
@@ -0,0 +1,125 @@
+package mutesting
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+
+	"github.com/VirtualRoyalty/go-mutesting/internal/models"
+)
+
+// Algorithms of the checksums of mutations
+const (
+	// ChecksumSHA256 hashes the mutator, the position and the source code of a mutation, so equal source code of different mutators or positions is told apart
+	ChecksumSHA256 = "sha256"
+	// ChecksumMD5 hashes only the source code of a mutation just like earlier versions
+	ChecksumMD5 = "md5"
+)
+
+// idLength is the count of hexadecimal characters of the IDs of mutations.
+const idLength = 16
+
+// mutationChecksums identifies mutations by their checksums and detects duplicated and blacklisted mutations.
+type mutationChecksums struct {
+	algorithm string
+	// seen maps the checksums of the source code of already seen mutations to their first mutant
+	seen map[string]*models.MutantReference
+	// blacklist holds the checksums of mutations which are ignored
+	blacklist map[string]bool
+	// legacy is set if the blacklist has MD5 checksums of earlier versions which are matched against the source code of mutations
+	legacy bool
+	// ids counts the mutations of every mutator at every position to tell apart multiple mutations of the same node
+	ids map[string]int
+}
+
+func newMutationChecksums(algorithm string) (*mutationChecksums, error) {
+	switch algorithm {
+	case "":
+		algorithm = ChecksumSHA256
+	case ChecksumSHA256, ChecksumMD5:
+	default:
+		return nil, fmt.Errorf("unknown checksum algorithm %q", algorithm)
+	}
+
+	return &mutationChecksums{
+		algorithm: algorithm,
+		seen:      map[string]*models.MutantReference{},
+		blacklist: map[string]bool{},
+		ids:       map[string]int{},
+	}, nil
+}
+
+// addBlacklisted adds a MD5 or SHA-256 checksum of a blacklist.
+func (c *mutationChecksums) addBlacklisted(checksum string) error {
+	switch len(checksum) {
+	case 2 * md5.Size:
+		c.legacy = true
+	case 2 * sha256.Size:
+	default:
+		return fmt.Errorf("%q is not a MD5 or SHA-256 checksum", checksum)
+	}
+
+	c.blacklist[checksum] = true
+
+	return nil
+}
+
+// checksum returns the checksum of the mutation of the mutator at the given position with the printed source code.
+func (c *mutationChecksums) checksum(mutatorName string, position string, line int64, src []byte) string {
+	if c.algorithm == ChecksumMD5 {
+		return md5Checksum(src)
+	}
+
+	h := sha256.New()
+	_, _ = fmt.Fprintf(h, "%s\x00%s:%d\x00", mutatorName, position, line)
+	_, _ = h.Write(src)
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// id returns the ID of the mutation of the mutator at the given position of the file.
+// Unlike the checksum it does not depend on the source code, so it is the same in every run as long as the mutated code does not move.
+func (c *mutationChecksums) id(file string, mutatorName string, line int64, column int64) string {
+	key := fmt.Sprintf("%s\x00%s\x00%d:%d", filepath.ToSlash(file), mutatorName, line, column)
+
+	// Further mutations of the same node are numbered in the order of the mutator
+	n := c.ids[key]
+	c.ids[key]++
+	if n > 0 {
+		key = fmt.Sprintf("%s\x00%d", key, n)
+	}
+
+	sum := sha256.Sum256([]byte(key))
+
+	return hex.EncodeToString(sum[:])[:idLength]
+}
+
+// duplicate records the mutation with the printed source code and checks if it is blacklisted or duplicates an earlier mutation.
+// The returned original mutant is nil for blacklisted mutations.
+func (c *mutationChecksums) duplicate(mutant *models.MutantReference, src []byte) (*models.MutantReference, bool) {
+	if c.blacklist[mutant.Checksum] || (c.legacy && c.blacklist[md5Checksum(src)]) {
+		return nil, true
+	}
+
+	// Mutations are duplicates if their source code is the same regardless of their mutator
+	key := mutant.Checksum
+	if c.algorithm != ChecksumMD5 {
+		sum := sha256.Sum256(src)
+		key = hex.EncodeToString(sum[:])
+	}
+
+	if original, ok := c.seen[key]; ok {
+		return original, true
+	}
+	c.seen[key] = mutant
+
+	return nil, false
+}
+
+func md5Checksum(src []byte) string {
+	sum := md5.Sum(src)
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,86 @@
+package mutesting
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/VirtualRoyalty/go-mutesting/internal/models"
+)
+
+func TestMutationChecksums(t *testing.T) {
+	src := []byte("package a\n")
+
+	c, err := newMutationChecksums(ChecksumSHA256)
+	assert.NoError(t, err)
+
+	a := c.checksum("numbers/incrementer", "a/a.go", 3, src)
+	assert.Len(t, a, 64)
+	assert.Equal(t, a, c.checksum("numbers/incrementer", "a/a.go", 3, src))
+	assert.NotEqual(t, a, c.checksum("numbers/decrementer", "a/a.go", 3, src))
+	assert.NotEqual(t, a, c.checksum("numbers/incrementer", "a/a.go", 4, src))
+
+	first := &models.MutantReference{Checksum: a}
+	original, duplicate := c.duplicate(first, src)
+	assert.False(t, duplicate)
+	assert.Nil(t, original)
+
+	// The same source code of another mutator is a duplicate
+	second := &models.MutantReference{Checksum: c.checksum("numbers/decrementer", "a/a.go", 3, src)}
+	original, duplicate = c.duplicate(second, src)
+	assert.True(t, duplicate)
+	assert.Equal(t, first, original)
+
+	md5, err := newMutationChecksums(ChecksumMD5)
+	assert.NoError(t, err)
+	assert.Equal(t, "a47bbde18f8e8e7fe159ce6456d4e7aa", md5.checksum("numbers/incrementer", "a/a.go", 3, src))
+
+	_, err = newMutationChecksums("crc32")
+	assert.EqualError(t, err, `unknown checksum algorithm "crc32"`)
+}
+
+func TestMutationChecksumsID(t *testing.T) {
+	c, err := newMutationChecksums(ChecksumSHA256)
+	assert.NoError(t, err)
+
+	first := c.id("a/a.go", "numbers/incrementer", 3, 7)
+	assert.Len(t, first, idLength)
+
+	// Further mutations of the same node get their own IDs
+	second := c.id("a/a.go", "numbers/incrementer", 3, 7)
+	assert.NotEqual(t, first, second)
+
+	assert.NotEqual(t, first, c.id("a/a.go", "numbers/decrementer", 3, 7))
+	assert.NotEqual(t, first, c.id("a/a.go", "numbers/incrementer", 3, 8))
+	assert.NotEqual(t, first, c.id("a/b.go", "numbers/incrementer", 3, 7))
+
+	// The IDs do not depend on the run
+	other, err := newMutationChecksums(ChecksumMD5)
+	assert.NoError(t, err)
+	assert.Equal(t, first, other.id("a/a.go", "numbers/incrementer", 3, 7))
+	assert.Equal(t, second, other.id("a/a.go", "numbers/incrementer", 3, 7))
+}
+
+func TestMutationChecksumsBlacklist(t *testing.T) {
+	src := []byte("package a\n")
+
+	c, err := newMutationChecksums(ChecksumSHA256)
+	assert.NoError(t, err)
+
+	assert.EqualError(t, c.addBlacklisted("abc"), `"abc" is not a MD5 or SHA-256 checksum`)
+
+	// MD5 checksums of earlier versions match the source code
+	assert.NoError(t, c.addBlacklisted(md5Checksum(src)))
+
+	original, duplicate := c.duplicate(&models.MutantReference{Checksum: c.checksum("numbers/incrementer", "a/a.go", 3, src)}, src)
+	assert.True(t, duplicate)
+	assert.Nil(t, original)
+
+	other := []byte("package b\n")
+	checksum := c.checksum("numbers/incrementer", "b/b.go", 3, other)
+	assert.NoError(t, c.addBlacklisted(checksum))
+
+	original, duplicate = c.duplicate(&models.MutantReference{Checksum: checksum}, other)
+	assert.True(t, duplicate)
+	assert.Nil(t, original)
+}
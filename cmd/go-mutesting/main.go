@@ -2,43 +2,40 @@ package main
 
 import (
 	"bytes"
-	"crypto/md5"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"go/ast"
-	"go/format"
-	"go/printer"
-	"go/token"
-	"go/types"
 	"io"
-	"log"
 	"os"
-	"os/exec"
-	"path/filepath"
-	"regexp"
 	"strings"
-	"syscall"
+	"time"
 
 	"gopkg.in/yaml.v3"
 
-	"github.com/VirtualRoyalty/go-mutesting/internal/annotation"
 	"github.com/VirtualRoyalty/go-mutesting/internal/console"
-	"github.com/VirtualRoyalty/go-mutesting/internal/filter"
-	"github.com/VirtualRoyalty/go-mutesting/internal/importing"
+	"github.com/VirtualRoyalty/go-mutesting/internal/gitdiff"
 	"github.com/VirtualRoyalty/go-mutesting/internal/models"
 	"github.com/VirtualRoyalty/go-mutesting/internal/parser"
+	"github.com/VirtualRoyalty/go-mutesting/internal/plugin"
 	"github.com/jessevdk/go-flags"
-	"github.com/VirtualRoyalty/osutil"
 
 	"github.com/VirtualRoyalty/go-mutesting"
-	"github.com/VirtualRoyalty/go-mutesting/astutil"
 	"github.com/VirtualRoyalty/go-mutesting/mutator"
 	_ "github.com/VirtualRoyalty/go-mutesting/mutator/arithmetic"
 	_ "github.com/VirtualRoyalty/go-mutesting/mutator/branch"
+	_ "github.com/VirtualRoyalty/go-mutesting/mutator/concurrency"
+	_ "github.com/VirtualRoyalty/go-mutesting/mutator/conditional"
+	_ "github.com/VirtualRoyalty/go-mutesting/mutator/embedding"
+	_ "github.com/VirtualRoyalty/go-mutesting/mutator/errors"
 	_ "github.com/VirtualRoyalty/go-mutesting/mutator/expression"
+	_ "github.com/VirtualRoyalty/go-mutesting/mutator/literals"
 	_ "github.com/VirtualRoyalty/go-mutesting/mutator/loop"
+	_ "github.com/VirtualRoyalty/go-mutesting/mutator/maps"
 	_ "github.com/VirtualRoyalty/go-mutesting/mutator/numbers"
+	_ "github.com/VirtualRoyalty/go-mutesting/mutator/slices"
 	_ "github.com/VirtualRoyalty/go-mutesting/mutator/statement"
+	_ "github.com/VirtualRoyalty/go-mutesting/mutator/stdlib"
 )
 
 const (
@@ -46,12 +43,18 @@ const (
 	returnHelp
 	returnBashCompletion
 	returnError
+	returnMsiBelowThreshold
+	returnEscaped
 )
 
+// precommitTimeBudget is the time budget of the precommit command if none is given.
+const precommitTimeBudget = 2 * time.Minute
+
 func checkArguments(args []string, opts *models.Options) (bool, int) {
 	p := flags.NewNamedParser("go-mutesting", flags.None)
 
 	p.ShortDescription = "Mutation testing for Go source code"
+	p.Usage = "[mutate|precommit] [OPTIONS] [Targets...]"
 
 	if _, err := p.AddGroup("go-mutesting", "go-mutesting arguments", opts); err != nil {
 		return true, exitError(err.Error())
@@ -65,6 +68,12 @@ func checkArguments(args []string, opts *models.Options) (bool, int) {
 
 		return true, returnHelp
 	} else if opts.Mutator.ListMutators {
+		for _, path := range opts.Mutator.MutatorPlugins {
+			if _, err := plugin.LoadGo(path); err != nil {
+				return true, exitError("Could not load mutator plugin %q: %v", path, err)
+			}
+		}
+
 		for _, name := range mutator.List() {
 			fmt.Println(name)
 		}
@@ -95,6 +104,10 @@ func checkArguments(args []string, opts *models.Options) (bool, int) {
 		}
 	}
 
+	if opts.Report.MinMsi == 0 {
+		opts.Report.MinMsi = opts.Config.MinMsi
+	}
+
 	return false, 0
 }
 
@@ -104,497 +117,293 @@ func exitError(format string, args ...interface{}) int {
 	return returnError
 }
 
-type mutatorItem struct {
-	Name    string
-	Mutator mutator.Mutator
-}
-
 func mainCmd(args []string) int {
 	var opts = &models.Options{}
-	var mutationBlackList = map[string]struct{}{}
 
-	if exit, exitCode := checkArguments(args, opts); exit {
-		return exitCode
+	if len(args) > 0 && args[0] == "suggest" {
+		return suggestCmd(args[1:])
+	} else if len(args) > 0 && args[0] == "suggest-tests" {
+		return suggestTestsCmd(args[1:])
+	} else if len(args) > 0 && args[0] == "report" {
+		return reportCmd(args[1:])
+	} else if len(args) > 0 && args[0] == "trend" {
+		return trendCmd(args[1:])
 	}
 
-	files := importing.FilesOfArgs(opts.Remaining.Targets, opts)
-	if len(files) == 0 {
-		return exitError("Could not find any suitable Go source files")
+	// The mutate command only generates the mutations as patches without executing them
+	mutateCommand := len(args) > 0 && args[0] == "mutate"
+	if mutateCommand {
+		args = args[1:]
 	}
 
-	if opts.Files.ListFiles {
-		for _, file := range files {
-			fmt.Println(file)
-		}
-
-		return returnOk
-	} else if opts.Files.PrintAST {
-		for _, file := range files {
-			fmt.Println(file)
-
-			src, _, err := parser.ParseFile(file)
-			if err != nil {
-				return exitError("Could not open file %q: %v", file, err)
-			}
-
-			mutesting.PrintWalk(src)
-
-			fmt.Println()
+	// The precommit command mutates only the staged functions with a time budget and stops at the first escaped mutation
+	precommitCommand := len(args) > 0 && args[0] == "precommit"
+	if precommitCommand {
+		args = args[1:]
+		if len(args) == 0 {
+			args = []string{"./..."}
 		}
-
-		return returnOk
 	}
 
-	if len(opts.Files.Blacklist) > 0 {
-		for _, f := range opts.Files.Blacklist {
-			c, err := os.ReadFile(f)
-			if err != nil {
-				return exitError("Cannot read blacklist file %q: %v", f, err)
-			}
-
-			for _, line := range strings.Split(string(c), "\n") {
-				if line == "" {
-					continue
-				}
-
-				if len(line) != 32 {
-					return exitError("%q is not a MD5 checksum", line)
-				}
+	if exit, exitCode := checkArguments(args, opts); exit {
+		return exitCode
+	}
 
-				mutationBlackList[line] = struct{}{}
-			}
-		}
+	if precommitCommand {
+		precommitOptions(opts)
 	}
 
-	var mutators []mutatorItem
+	outputs, err := reportOutputs(opts)
+	if err != nil {
+		return exitError(err.Error())
+	}
 
-MUTATOR:
-	for _, name := range mutator.List() {
-		if len(opts.Mutator.DisableMutators) > 0 {
-			for _, d := range opts.Mutator.DisableMutators {
-				pattern := strings.HasSuffix(d, "*")
+	runner := mutesting.NewRunner(opts)
+	runner.Mutate = mutateCommand
 
-				if (pattern && strings.HasPrefix(name, d[:len(d)-2])) || (!pattern && name == d) {
-					continue MUTATOR
-				}
-			}
+	if opts.General.JSON {
+		if opts.Report.Stream != "" && opts.Report.StreamFile == "" {
+			return exitError("The events of --stream can not be written to the standard output with --json, use --stream-file")
+		} else if opts.Exec.DryRun {
+			return exitError("The mutations of --dry-run are printed instead of a report, they can not be used with --json")
 		}
 
-		console.Verbose(opts, "Enable mutator %q", name)
-
-		m, _ := mutator.New(name)
-		mutators = append(mutators, mutatorItem{
-			Name:    name,
-			Mutator: m,
-		})
+		// The standard output is reserved for the report
+		runner.Output = io.Discard
 	}
 
-	tmpDir, err := os.MkdirTemp("", "go-mutesting-")
-	if err != nil {
-		panic(err)
+	output := runner.Output
+	if output == nil {
+		output = os.Stdout
 	}
-	console.Verbose(opts, "Save mutations into %q", tmpDir)
-
-	var execs []string
-	if opts.Exec.Exec != "" {
-		execs = strings.Split(opts.Exec.Exec, " ")
+	renderer, err := console.NewRenderer(opts.General.Console, output)
+	if err != nil {
+		return exitError(err.Error())
 	}
+	// The summary is rendered just like the mutations of the run
+	console.SetRenderer(renderer)
+	runner.Renderer = renderer
 
-	report := &models.Report{}
-
-	for _, file := range files {
-		console.Verbose(opts, "Mutate %q", file)
-
-		annotationProcessor := annotation.NewProcessor()
-		skipFilterProcessor := filter.NewSkipMakeArgsFilter()
-
-		collectors := []filter.NodeCollector{
-			annotationProcessor,
-			skipFilterProcessor,
-		}
-
-		filters := []filter.NodeFilter{
-			annotationProcessor,
-			skipFilterProcessor,
-		}
-
-		src, fset, pkg, info, err := parser.ParseAndTypeCheckFile(file, collectors)
+	if opts.Files.ListFiles || opts.Files.PrintAST {
+		files, err := runner.Files()
 		if err != nil {
 			return exitError(err.Error())
 		}
 
-		err = os.MkdirAll(tmpDir+"/"+filepath.Dir(file), 0755)
-		if err != nil {
-			panic(err)
-		}
-
-		tmpFile := tmpDir + "/" + file
-
-		originalFile := fmt.Sprintf("%s.original", tmpFile)
-		err = osutil.CopyFile(file, originalFile)
-		if err != nil {
-			panic(err)
-		}
-		console.Debug(opts, "Save original into %q", originalFile)
+		for _, file := range files {
+			fmt.Println(file)
 
-		mutationID := 0
+			if opts.Files.ListFiles {
+				continue
+			}
 
-		if opts.Filter.Match != "" {
-			m, err := regexp.Compile(opts.Filter.Match)
+			src, _, err := parser.ParseFile(file)
 			if err != nil {
-				return exitError("Match regex is not valid: %v", err)
+				return exitError("Could not open file %q: %v", file, err)
 			}
 
-			for _, f := range astutil.Functions(src) {
-				if m.MatchString(f.Name.Name) {
-					mutationID = mutate(opts, mutators, mutationBlackList, mutationID, pkg, info, file, fset, src, f, tmpFile, execs, report, filters)
-				}
-			}
-		} else {
-			_ = mutate(opts, mutators, mutationBlackList, mutationID, pkg, info, file, fset, src, src, tmpFile, execs, report, filters)
+			mutesting.PrintWalk(src)
+
+			fmt.Println()
 		}
+
+		return returnOk
 	}
 
-	if !opts.General.DoNotRemoveTmpFolder {
-		err = os.RemoveAll(tmpDir)
-		if err != nil {
-			panic(err)
+	report, err := runner.Run(context.Background())
+	if precommitCommand && errors.Is(err, mutesting.ErrNoFiles) {
+		message := "There are no staged changes of Go source files to mutate"
+		if opts.General.JSON {
+			_, _ = fmt.Fprintln(os.Stderr, message)
+		} else {
+			fmt.Println(message)
 		}
-		console.Debug(opts, "Remove %q", tmpDir)
+
+		return returnOk
+	} else if err != nil {
+		return exitError(err.Error())
 	}
 
-	report.Calculate()
+	if mutateCommand || opts.Exec.DryRun {
+		return returnOk
+	}
 
 	if !opts.Exec.NoExec {
 		if !opts.Config.SilentMode {
-			fmt.Printf("The mutation score is %f (%d passed, %d failed, %d duplicated, %d skipped, total is %d)\n",
+			summary := []string{fmt.Sprintf("The mutation score is %f (%d passed, %d failed, %d duplicated, %d skipped, total is %d)",
 				report.Stats.Msi,
 				report.Stats.KilledCount,
 				report.Stats.EscapedCount,
 				report.Stats.DuplicatedCount,
 				report.Stats.SkippedCount,
 				report.Stats.TotalMutantsCount,
-			)
-		}
-	} else {
-		fmt.Println("Cannot do a mutation testing summary since no exec command was executed.")
-	}
-
-	jsonContent, err := json.Marshal(report)
-	if err != nil {
-		return exitError(err.Error())
-	}
-
-	file, err := os.OpenFile(models.ReportFileName, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0666)
-	if err != nil {
-		return exitError(err.Error())
-	}
+			)}
+
+			if opts.Filter.CoverProfile != "" || opts.Test.Selection {
+				summary = append(summary, fmt.Sprintf("The mutation code coverage is %d%% (%d not covered) and the covered code mutation score is %f",
+					report.Stats.MutationCodeCoverage,
+					report.Stats.NotCoveredCount,
+					report.Stats.CoveredCodeMsi,
+				))
+			}
 
-	if file == nil {
-		return exitError("Cannot create file for report")
-	}
+			if opts.Config.ExcludeNotCovered && report.Stats.NotCoveredCount > 0 {
+				summary = append(summary, fmt.Sprintf("The mutation score excludes %d not covered mutations", report.Stats.NotCoveredCount))
+			}
 
-	defer func() {
-		err = file.Close()
-		if err != nil {
-			fmt.Printf("Error while report file closing: %v", err.Error())
-		}
-	}()
+			if opts.Exec.Vet {
+				summary = append(summary, fmt.Sprintf("%d mutations were caught by go vet without executing their tests", report.Stats.CaughtByVetCount))
+			}
 
-	_, err = file.WriteString(string(jsonContent))
-	if err != nil {
-		return exitError(err.Error())
-	}
+			if opts.Filter.SampleRate < 1 {
+				summary = append(summary, fmt.Sprintf("The mutation score is computed over a random sample of %g%% of the mutations with seed %d", opts.Filter.SampleRate*100, opts.Filter.Seed))
+			}
 
-	console.Verbose(opts, "Save report into %q", models.ReportFileName)
+			if len(report.UntestedPackages) > 0 {
+				summary = append(summary, fmt.Sprintf("The mutations of %d packages without test files were not executed: %s", len(report.UntestedPackages), strings.Join(report.UntestedPackages, ", ")))
+			}
 
-	return returnOk
-}
+			if len(report.ExcludedFiles) > 0 {
+				excluded := make([]string, len(report.ExcludedFiles))
+				for i, file := range report.ExcludedFiles {
+					excluded[i] = file.File
+				}
 
-func mutate(
-	opts *models.Options,
-	mutators []mutatorItem,
-	mutationBlackList map[string]struct{},
-	mutationID int,
-	pkg *types.Package,
-	info *types.Info,
-	originalFile string,
-	fset *token.FileSet,
-	src ast.Node,
-	node ast.Node,
-	mutatedFile string,
-	execs []string,
-	stats *models.Report,
-	filters []filter.NodeFilter,
-) int {
-	for _, m := range mutators {
-		console.Debug(opts, "Mutator %s", m.Name)
-
-		mutatorAnnotated := annotation.DecoratorFilter(m.Mutator, m.Name, filters...)
-
-		changed := mutesting.MutateWalk(pkg, info, node, mutatorAnnotated)
-
-		for {
-			_, ok := <-changed
-
-			if !ok {
-				break
+				summary = append(summary, fmt.Sprintf("%d files were not mutated since they are too large: %s", len(report.ExcludedFiles), strings.Join(excluded, ", ")))
 			}
 
-			originalSourceCode, err := os.ReadFile(originalFile)
-			if err != nil {
-				log.Fatal(err)
+			switch report.Stopped {
+			case models.StoppedByFailFast:
+				summary = append(summary, "The execution was stopped after the first escaped mutation")
+			case models.StoppedByTimeBudget:
+				summary = append(summary, fmt.Sprintf("The execution was stopped after the time budget of %s, the remaining mutations were not executed", opts.Exec.TimeBudget))
 			}
 
-			mutant := models.Mutant{}
-			mutant.Mutator.MutatorName = m.Name
-			mutant.Mutator.OriginalFilePath = originalFile
-			mutant.Mutator.OriginalSourceCode = string(originalSourceCode)
-
-			mutationFile := fmt.Sprintf("%s.%d", mutatedFile, mutationID)
-			checksum, duplicate, err := saveAST(mutationBlackList, mutationFile, fset, src)
-			if err != nil {
-				fmt.Printf("INTERNAL ERROR %s\n", err.Error())
-			} else if duplicate {
-				console.Debug(opts, "%q is a duplicate, we ignore it", mutationFile)
-
-				stats.Stats.DuplicatedCount++
-			} else {
-				console.Debug(opts, "Save mutation into %q with checksum %s", mutationFile, checksum)
-
-				if !opts.Exec.NoExec {
-					execExitCode := mutateExec(opts, pkg, originalFile, src, mutationFile, execs, &mutant)
-
-					console.Debug(opts, "Exited with %d", execExitCode)
-
-					mutatedSourceCode, err := os.ReadFile(mutationFile)
-					if err != nil {
-						log.Fatal(err)
-					}
-					mutant.Mutator.MutatedSourceCode = string(mutatedSourceCode)
-
-					msg := fmt.Sprintf("%q with checksum %s", mutationFile, checksum)
-
-					switch execExitCode {
-					case 0: // Tests failed - all ok
-						out := fmt.Sprintf("PASS %s\n", msg)
-						if !opts.Config.SilentMode {
-							console.PrintPass(out)
-						}
-
-						mutant.ProcessOutput = out
-						stats.Killed = append(stats.Killed, mutant)
-						stats.Stats.KilledCount++
-					case 1: // Tests passed
-						out := fmt.Sprintf("FAIL %s\n", msg)
-						if !opts.Config.SilentMode {
-							console.PrintFail(out)
-						}
-
-						mutant.ProcessOutput = out
-						stats.Escaped = append(stats.Escaped, mutant)
-						stats.Stats.EscapedCount++
-					case 2: // Did not compile
-						out := fmt.Sprintf("SKIP %s\n", msg)
-						if !opts.Config.SilentMode {
-							console.PrintSkip(out)
-						}
-
-						mutant.ProcessOutput = out
-						stats.Stats.SkippedCount++
-					default:
-						out := fmt.Sprintf("UNKOWN exit code for %s\n", msg)
-						if !opts.Config.SilentMode {
-							console.PrintUnknown(out)
-						}
-
-						mutant.ProcessOutput = out
-						stats.Errored = append(stats.Errored, mutant)
-						stats.Stats.ErrorCount++
-					}
-				}
+			if report.StrictNewCode != "" {
+				summary = append(summary, fmt.Sprintf("%d of the %d escaped mutations are in code which changed compared to %q", len(report.NewCodeEscaped()), report.Stats.EscapedCount, report.StrictNewCode))
 			}
 
-			changed <- true
-
-			// Ignore original state
-			<-changed
-			changed <- true
+			if opts.Test.Run != "" {
+				summary = append(summary, fmt.Sprintf("Only tests matching %q were executed, the verdicts hold only for these tests", opts.Test.Run))
+			}
 
-			mutationID++
+			console.Summary(summary, report)
 		}
+	} else {
+		console.Message("Cannot do a mutation testing summary since no exec command was executed.")
 	}
 
-	return mutationID
-}
-
-func mutateExec(
-	opts *models.Options,
-	pkg *types.Package,
-	file string,
-	src ast.Node,
-	mutationFile string,
-	execs []string,
-	mutant *models.Mutant,
-) (execExitCode int) {
-	if len(execs) == 0 {
-		console.Debug(opts, "Execute built-in exec command for mutation")
-
-		diff, err := exec.Command("diff", "--label=Original", "--label=New", "-u", file, mutationFile).CombinedOutput()
-
-		startLine := parser.FindOriginalStartLine(diff)
-		mutant.Mutator.OriginalStartLine = startLine
-
-		if err == nil {
-			execExitCode = 0
-		} else if e, ok := err.(*exec.ExitError); ok {
-			execExitCode = e.Sys().(syscall.WaitStatus).ExitStatus()
-		} else {
-			panic(err)
-		}
-		if execExitCode != 0 && execExitCode != 1 {
-			fmt.Printf("%s\n", diff)
-
-			panic("Could not execute diff on mutation file")
-		}
-
-		defer func() {
-			_ = os.Rename(file+".tmp", file)
-		}()
-
-		err = os.Rename(file, file+".tmp")
+	if opts.General.JSON {
+		err = json.NewEncoder(os.Stdout).Encode(report)
 		if err != nil {
-			panic(err)
+			return exitError(err.Error())
 		}
-		err = osutil.CopyFile(mutationFile, file)
+	}
+
+	for _, output := range outputs {
+		content, err := renderReport(report, output.format)
 		if err != nil {
-			panic(err)
+			return exitError(err.Error())
 		}
 
-		pkgName := pkg.Path()
-		if opts.Test.Recursive {
-			pkgName += "/..."
+		err = saveReport(output.path, content)
+		if err != nil {
+			return exitError(err.Error())
 		}
 
-		goTestCmd := exec.Command("go", "test", "-timeout", fmt.Sprintf("%ds", opts.Exec.Timeout), pkgName)
-		goTestCmd.Env = os.Environ()
+		console.Verbose(opts, "Save %s report into %q", output.format, output.path)
+	}
 
-		test, err := goTestCmd.CombinedOutput()
-		if err == nil {
-			execExitCode = 0
-		} else if e, ok := err.(*exec.ExitError); ok {
-			execExitCode = e.Sys().(syscall.WaitStatus).ExitStatus()
-		} else {
-			panic(err)
+	if matrix := runner.KillMatrix(); matrix != nil {
+		var csv bytes.Buffer
+		err = matrix.WriteCSV(&csv)
+		if err != nil {
+			return exitError(err.Error())
 		}
 
-		if opts.General.Debug {
-			fmt.Printf("%s\n", test)
+		err = saveReport(opts.Report.ExportMatrix, csv.Bytes())
+		if err != nil {
+			return exitError(err.Error())
 		}
 
-		mutant.Diff = string(diff)
-
-		switch execExitCode {
-		case 0: // Tests passed -> FAIL
-			if !opts.Config.SilentMode {
-				console.PrintDiff(diff)
-			}
-
-			execExitCode = 1
-		case 1: // Tests failed -> PASS
-			if opts.General.Debug {
-				console.PrintDiff(diff)
-			}
+		console.Verbose(opts, "Save kill matrix into %q", opts.Report.ExportMatrix)
+	}
 
-			execExitCode = 0
-		case 2: // Did not compile -> SKIP
-			if opts.General.Verbose {
-				fmt.Println("Mutation did not compile")
-			}
+	if opts.Report.HistoryFile != "" && !opts.Exec.NoExec {
+		// Runs outside of git repositories are recorded without a commit
+		commit, err := gitdiff.Head()
+		if err != nil {
+			console.Verbose(opts, "Could not get the git commit of the history: %v", err)
+		}
 
-			if opts.General.Debug {
-				console.PrintDiff(diff)
-			}
-		default: // Unknown exit code -> SKIP
-			if !opts.Config.SilentMode {
-				fmt.Println("Unknown exit code")
-				console.PrintDiff(diff)
-			}
+		err = models.AppendHistory(opts.Report.HistoryFile, models.NewHistoryEntry(report, commit, time.Now()))
+		if err != nil {
+			return exitError("Could not append to history file %q: %v", opts.Report.HistoryFile, err)
 		}
 
-		return execExitCode
+		console.Verbose(opts, "Append the mutation score to the history file %q", opts.Report.HistoryFile)
 	}
 
-	console.Debug(opts, "Execute %q for mutation", opts.Exec.Exec)
-
-	execCommand := exec.Command(execs[0], execs[1:]...)
+	if !opts.Exec.NoExec && report.Stats.Msi < opts.Report.MinMsi {
+		_, _ = fmt.Fprintf(os.Stderr, "The mutation score %f is below the minimum of %f\n", report.Stats.Msi, opts.Report.MinMsi)
 
-	execCommand.Stderr = os.Stderr
-	execCommand.Stdout = os.Stdout
-
-	execCommand.Env = append(os.Environ(), []string{
-		"MUTATE_CHANGED=" + mutationFile,
-		fmt.Sprintf("MUTATE_DEBUG=%t", opts.General.Debug),
-		"MUTATE_ORIGINAL=" + file,
-		"MUTATE_PACKAGE=" + pkg.Path(),
-		fmt.Sprintf("MUTATE_TIMEOUT=%d", opts.Exec.Timeout),
-		fmt.Sprintf("MUTATE_VERBOSE=%t", opts.General.Verbose),
-	}...)
-	if opts.Test.Recursive {
-		execCommand.Env = append(execCommand.Env, "TEST_RECURSIVE=true")
+		return returnMsiBelowThreshold
 	}
 
-	err := execCommand.Start()
-	if err != nil {
-		panic(err)
-	}
+	if escaped := report.NewCodeEscaped(); len(escaped) > 0 {
+		_, _ = fmt.Fprintf(os.Stderr, "%d mutations of code which changed compared to %q escaped, add tests which kill them\n", len(escaped), report.StrictNewCode)
 
-	// TODO timeout here
+		return returnEscaped
+	}
 
-	err = execCommand.Wait()
+	if precommitCommand && report.Stats.EscapedCount > 0 {
+		_, _ = fmt.Fprintf(os.Stderr, "The commit is blocked since a mutation of the staged changes escaped, add a test which kills it\n")
 
-	if err == nil {
-		execExitCode = 0
-	} else if e, ok := err.(*exec.ExitError); ok {
-		execExitCode = e.Sys().(syscall.WaitStatus).ExitStatus()
-	} else {
-		panic(err)
+		return returnEscaped
 	}
 
-	return execExitCode
-}
-
-func main() {
-	os.Exit(mainCmd(os.Args[1:]))
+	return returnOk
 }
 
-func saveAST(mutationBlackList map[string]struct{}, file string, fset *token.FileSet, node ast.Node) (string, bool, error) {
-	var buf bytes.Buffer
+// precommitOptions sets the options of the precommit command which are not given as arguments.
+func precommitOptions(opts *models.Options) {
+	opts.Filter.Staged = true
+	opts.Filter.ChangedFunctions = true
+	opts.Exec.FailFast = true
 
-	h := md5.New()
+	if opts.Exec.TimeBudget == 0 {
+		opts.Exec.TimeBudget = precommitTimeBudget
+	}
+	if len(opts.Mutator.Packs) == 0 {
+		opts.Mutator.Packs = []string{mutesting.PackFast}
+	}
+	if len(opts.Remaining.Targets) == 0 {
+		opts.Remaining.Targets = []string{"./..."}
+	}
+}
 
-	err := printer.Fprint(io.MultiWriter(h, &buf), fset, node)
+func saveReport(fileName string, content []byte) (err error) {
+	file, err := os.OpenFile(fileName, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0666)
 	if err != nil {
-		return "", false, err
+		return err
 	}
 
-	checksum := fmt.Sprintf("%x", h.Sum(nil))
-
-	if _, ok := mutationBlackList[checksum]; ok {
-		return checksum, true, nil
+	if file == nil {
+		return fmt.Errorf("cannot create file %q for report", fileName)
 	}
 
-	mutationBlackList[checksum] = struct{}{}
+	defer func() {
+		closeErr := file.Close()
+		if closeErr != nil {
+			fmt.Printf("Error while report file closing: %v", closeErr.Error())
+		}
+	}()
 
-	src, err := format.Source(buf.Bytes())
-	if err != nil {
-		return "", false, err
-	}
+	_, err = file.Write(content)
 
-	err = os.WriteFile(file, src, 0666)
-	if err != nil {
-		return "", false, err
-	}
+	return err
+}
 
-	return checksum, false, nil
+func main() {
+	os.Exit(mainCmd(os.Args[1:]))
 }
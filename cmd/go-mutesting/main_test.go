@@ -6,6 +6,9 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/VirtualRoyalty/go-mutesting/internal/models"
@@ -19,7 +22,7 @@ func TestMainSimple(t *testing.T) {
 		"../../example",
 		[]string{"--debug", "--exec-timeout", "1"},
 		returnOk,
-		"The mutation score is 0.564516 (35 passed, 27 failed, 8 duplicated, 0 skipped, total is 62)",
+		"The mutation score is 0.565789 (43 passed, 33 failed, 9 duplicated, 0 skipped, total is 76)",
 	)
 }
 
@@ -29,7 +32,7 @@ func TestMainRecursive(t *testing.T) {
 		"../../example",
 		[]string{"--debug", "--exec-timeout", "1", "./..."},
 		returnOk,
-		"The mutation score is 0.590909 (39 passed, 27 failed, 8 duplicated, 0 skipped, total is 66)",
+		"The mutation score is 0.592593 (48 passed, 33 failed, 9 duplicated, 0 skipped, total is 81)",
 	)
 }
 
@@ -39,7 +42,7 @@ func TestMainFromOtherDirectory(t *testing.T) {
 		"../..",
 		[]string{"--debug", "--exec-timeout", "1", "github.com/VirtualRoyalty/go-mutesting/example"},
 		returnOk,
-		"The mutation score is 0.564516 (35 passed, 27 failed, 8 duplicated, 0 skipped, total is 62)",
+		"The mutation score is 0.565789 (43 passed, 33 failed, 9 duplicated, 0 skipped, total is 76)",
 	)
 }
 
@@ -49,7 +52,740 @@ func TestMainMatch(t *testing.T) {
 		"../../example",
 		[]string{"--debug", "--exec", "../scripts/exec/test-mutated-package.sh", "--exec-timeout", "1", "--match", "baz", "./..."},
 		returnOk,
-		"The mutation score is 0.500000 (4 passed, 4 failed, 0 duplicated, 0 skipped, total is 8)",
+		"The mutation score is 0.500000 (5 passed, 5 failed, 0 duplicated, 0 skipped, total is 10)",
+	)
+}
+
+func TestMainWorkers(t *testing.T) {
+	testMain(
+		t,
+		"../../example",
+		[]string{"--debug", "--exec-timeout", "1", "--workers", "4"},
+		returnOk,
+		"The mutation score is 0.565789 (43 passed, 33 failed, 9 duplicated, 0 skipped, total is 76)",
+	)
+}
+
+func TestMainMatchWorkers(t *testing.T) {
+	testMain(
+		t,
+		"../../example",
+		[]string{"--debug", "--exec", "../scripts/exec/test-mutated-package.sh", "--exec-timeout", "1", "--match", "baz", "--workers", "2", "./..."},
+		returnOk,
+		"The mutation score is 0.500000 (5 passed, 5 failed, 0 duplicated, 0 skipped, total is 10)",
+	)
+}
+
+func TestMainCoverProfile(t *testing.T) {
+	testMain(
+		t,
+		"../../example",
+		[]string{"--debug", "--exec-timeout", "1", "--coverprofile", "../testdata/coverage/example.out"},
+		returnOk,
+		"The mutation code coverage is 77% (17 not covered) and the covered code mutation score is 0.728814",
+	)
+}
+
+func TestMainExcludeNotCovered(t *testing.T) {
+	saveReportFileName := models.ReportFileName
+	defer func() {
+		models.ReportFileName = saveReportFileName
+	}()
+	models.ReportFileName = filepath.Join(t.TempDir(), "report.json")
+
+	testMain(
+		t,
+		"../../example",
+		[]string{"--exec-timeout", "1", "--coverprofile", "../testdata/coverage/example.out", "--config", "../testdata/configs/configExcludeNotCovered.yml.test"},
+		returnOk,
+		"The mutation score is 0.728814 (43 passed, 16 failed, 9 duplicated, 0 skipped, total is 76)",
+	)
+
+	content, err := os.ReadFile(models.ReportFileName)
+	assert.NoError(t, err)
+
+	var report models.Report
+	assert.NoError(t, json.Unmarshal(content, &report))
+	assert.True(t, report.ExcludeNotCovered)
+	assert.Len(t, report.NotCovered, 17)
+	assert.Equal(t, report.Stats.CoveredCodeMsi, report.Stats.Msi)
+	for _, mutant := range report.NotCovered {
+		assert.NotEmpty(t, mutant.Diff)
+		assert.NotZero(t, mutant.Mutator.OriginalStartLine)
+		assert.NotZero(t, mutant.Mutator.OriginalStartColumn)
+	}
+}
+
+func TestMainTestSelection(t *testing.T) {
+	testMain(
+		t,
+		"../../example",
+		[]string{"--debug", "--exec-timeout", "1", "--test-selection"},
+		returnOk,
+		"The mutation code coverage is 77% (17 not covered) and the covered code mutation score is 0.728814",
+	)
+}
+
+func TestMainTestRun(t *testing.T) {
+	saveReportFileName := models.ReportFileName
+	defer func() {
+		models.ReportFileName = saveReportFileName
+	}()
+	models.ReportFileName = filepath.Join(t.TempDir(), "report.json")
+
+	testMain(
+		t,
+		"../../example",
+		[]string{"--debug", "--exec-timeout", "1", "--match", "baz", "--test-run", "^TestNone$", "./..."},
+		returnOk,
+		"The mutation score is 0.000000 (0 passed, 10 failed, 0 duplicated, 0 skipped, total is 10)",
+	)
+
+	jsonData, err := os.ReadFile(models.ReportFileName)
+	assert.NoError(t, err)
+
+	var mutationReport models.Report
+	assert.NoError(t, json.Unmarshal(jsonData, &mutationReport))
+
+	assert.Len(t, mutationReport.Escaped, 10)
+	for _, mutant := range mutationReport.Escaped {
+		assert.Equal(t, "^TestNone$", mutant.TestRestriction)
+	}
+}
+
+func TestMainDuplicates(t *testing.T) {
+	saveReportFileName := models.ReportFileName
+	defer func() {
+		models.ReportFileName = saveReportFileName
+	}()
+	models.ReportFileName = filepath.Join(t.TempDir(), "report.json")
+
+	testMain(
+		t,
+		"../../example",
+		[]string{"--debug", "--exec-timeout", "1"},
+		returnOk,
+		"The mutation score is 0.565789 (43 passed, 33 failed, 9 duplicated, 0 skipped, total is 76)",
+	)
+
+	jsonData, err := os.ReadFile(models.ReportFileName)
+	assert.NoError(t, err)
+
+	var mutationReport models.Report
+	assert.NoError(t, json.Unmarshal(jsonData, &mutationReport))
+
+	assert.Len(t, mutationReport.Duplicates, 9)
+	for _, duplicate := range mutationReport.Duplicates {
+		if assert.NotNil(t, duplicate.Original) {
+			assert.Len(t, duplicate.Checksum, 64)
+			assert.Len(t, duplicate.Original.Checksum, 64)
+		}
+	}
+}
+
+func TestMainDuplicatesMD5(t *testing.T) {
+	saveReportFileName := models.ReportFileName
+	defer func() {
+		models.ReportFileName = saveReportFileName
+	}()
+	models.ReportFileName = filepath.Join(t.TempDir(), "report.json")
+
+	testMain(
+		t,
+		"../../example",
+		[]string{"--debug", "--exec-timeout", "1", "--checksum", "md5"},
+		returnOk,
+		"The mutation score is 0.565789 (43 passed, 33 failed, 9 duplicated, 0 skipped, total is 76)",
+	)
+
+	jsonData, err := os.ReadFile(models.ReportFileName)
+	assert.NoError(t, err)
+
+	var mutationReport models.Report
+	assert.NoError(t, json.Unmarshal(jsonData, &mutationReport))
+
+	assert.Len(t, mutationReport.Duplicates, 9)
+	for _, duplicate := range mutationReport.Duplicates {
+		if assert.NotNil(t, duplicate.Original) {
+			assert.Equal(t, duplicate.Checksum, duplicate.Original.Checksum)
+		}
+	}
+}
+
+func TestMainGoTestFlags(t *testing.T) {
+	testMain(
+		t,
+		"../../example",
+		[]string{"--debug", "--exec-timeout", "1", "--match", "baz", "--gotest-flags=-count=1 -run ^TestNone$", "./..."},
+		returnOk,
+		"The mutation score is 0.000000 (0 passed, 10 failed, 0 duplicated, 0 skipped, total is 10)",
+	)
+}
+
+func TestMainGoTestFlagsWithCustomExec(t *testing.T) {
+	testMain(
+		t,
+		"../../example",
+		[]string{"--exec", "../scripts/exec/test-mutated-package.sh", "--gotest-flags=-short", "./..."},
+		returnError,
+		"The flags of --gotest-flags are only passed to the go test command of the built-in exec command",
+	)
+}
+
+func TestMainConsoleJSON(t *testing.T) {
+	testMain(
+		t,
+		"../../example",
+		[]string{"--exec-timeout", "1", "--match", "baz", "--console", "json", "./..."},
+		returnOk,
+		`{"type":"summary","lines":["The mutation score is 0.500000 (5 passed, 5 failed, 0 duplicated, 0 skipped, total is 10)"]`,
+	)
+}
+
+func TestMainOutput(t *testing.T) {
+	saveReportFileName := models.ReportFileName
+	defer func() {
+		models.ReportFileName = saveReportFileName
+	}()
+	models.ReportFileName = filepath.Join(t.TempDir(), "report.json")
+
+	dir := t.TempDir()
+	jsonFile := filepath.Join(dir, "mutation.json")
+	htmlFile := filepath.Join(dir, "mutation.html")
+
+	testMain(
+		t,
+		"../../example",
+		[]string{"--no-exec", "--match", "baz", "--output", jsonFile, "--output", "html=" + htmlFile, "./..."},
+		returnOk,
+		"",
+	)
+
+	content, err := os.ReadFile(jsonFile)
+	assert.NoError(t, err)
+	var report models.Report
+	assert.NoError(t, json.Unmarshal(content, &report))
+
+	content, err = os.ReadFile(htmlFile)
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), "<h1>Mutation testing report</h1>")
+
+	// The default report file is not written anymore
+	_, err = os.Stat(models.ReportFileName)
+	assert.True(t, os.IsNotExist(err))
+
+	testMain(
+		t,
+		"../../example",
+		[]string{"--output", "pdf=report.pdf", "./..."},
+		returnError,
+		`Unknown format "pdf" of output "pdf=report.pdf"`,
+	)
+}
+
+func TestMainRunMetadata(t *testing.T) {
+	out := testMain(
+		t,
+		"../../example",
+		[]string{"--no-exec", "--match", "baz", "--json", "--run-name", "nightly-2024-06-01", "--label", "team=payments", "--label", "env=ci", "./..."},
+		returnOk,
+		`"runName":"nightly-2024-06-01","labels":{"env":"ci","team":"payments"}`,
+	)
+
+	var report models.Report
+	assert.NoError(t, json.Unmarshal([]byte(out), &report))
+	assert.True(t, report.HasLabels(map[string]string{"team": "payments"}))
+
+	testMain(
+		t,
+		"../../example",
+		[]string{"--label", "team", "./..."},
+		returnError,
+		`Label "team" is not of the form key=value`,
+	)
+}
+
+func TestMainDryRun(t *testing.T) {
+	saveReportFileName := models.ReportFileName
+	defer func() {
+		models.ReportFileName = saveReportFileName
+	}()
+	models.ReportFileName = filepath.Join(t.TempDir(), "report.json")
+
+	out := testMain(
+		t,
+		"../../example",
+		[]string{"--dry-run", "--match", "baz", "./..."},
+		returnOk,
+		"10 mutations would be executed",
+	)
+	assert.Contains(t, out, `example.go:52:6 arithmetic/base "i = i + i" -> "i = i - i"`)
+	assert.NotContains(t, out, "PASS")
+
+	_, err := os.Stat(models.ReportFileName)
+	assert.True(t, os.IsNotExist(err))
+
+	testMain(
+		t,
+		"../../example",
+		[]string{"--dry-run", "--json", "./..."},
+		returnError,
+		"The mutations of --dry-run are printed instead of a report",
+	)
+
+	testMain(
+		t,
+		"../../example",
+		[]string{"--dry-run", "--baseline", filepath.Join(t.TempDir(), "baseline.json"), "./..."},
+		returnError,
+		"The mutations of --dry-run are not written, they can not be compared with the --baseline report",
+	)
+}
+
+func TestMainQuietJSON(t *testing.T) {
+	saveReportFileName := models.ReportFileName
+	defer func() {
+		models.ReportFileName = saveReportFileName
+	}()
+	models.ReportFileName = filepath.Join(t.TempDir(), "report.json")
+
+	out := testMain(
+		t,
+		"../../example",
+		[]string{"--exec-timeout", "1", "--match", "baz", "--json", "./..."},
+		returnOk,
+		`{"stats":{"totalMutantsCount":10,"killedCount":5`,
+	)
+
+	var report models.Report
+	assert.NoError(t, json.Unmarshal([]byte(out), &report))
+	assert.Len(t, report.Killed, 5)
+	assert.Len(t, report.Escaped, 5)
+
+	_, err := os.Stat(models.ReportFileName)
+	assert.True(t, os.IsNotExist(err))
+
+	testMain(
+		t,
+		"../../example",
+		[]string{"--json", "--stream", "ndjson", "./..."},
+		returnError,
+		"The events of --stream can not be written to the standard output with --json",
+	)
+}
+
+func TestMainExclude(t *testing.T) {
+	testMain(
+		t,
+		"../../example",
+		[]string{"--exclude", "**/sub/**", "--exclude", "*.go", "./..."},
+		returnError,
+		"Could not find any suitable Go source files",
+	)
+}
+
+func TestMainExcludeInvalidGlob(t *testing.T) {
+	testMain(
+		t,
+		"../../example",
+		[]string{"--exclude", "[example.go", "./..."},
+		returnError,
+		`Could not exclude files: invalid glob "[example.go": syntax error in pattern`,
+	)
+}
+
+func TestMainVet(t *testing.T) {
+	saveReportFileName := models.ReportFileName
+	defer func() {
+		models.ReportFileName = saveReportFileName
+	}()
+	models.ReportFileName = filepath.Join(t.TempDir(), "report.json")
+
+	testMain(
+		t,
+		"../../testdata/vet",
+		[]string{"--exec-timeout", "1", "--exec-vet", "."},
+		returnOk,
+		"3 mutations were caught by go vet without executing their tests",
+	)
+
+	jsonData, err := os.ReadFile(models.ReportFileName)
+	assert.NoError(t, err)
+
+	var mutationReport models.Report
+	assert.NoError(t, json.Unmarshal(jsonData, &mutationReport))
+
+	assert.Equal(t, int64(3), mutationReport.Stats.CaughtByVetCount)
+	assert.Equal(t, int64(5), mutationReport.Stats.KilledCount)
+	assert.Equal(t, 1.0, mutationReport.Stats.Msi)
+	if assert.Len(t, mutationReport.CaughtByVet, 3) {
+		assert.Contains(t, mutationReport.CaughtByVet[0].ProcessOutput, "suspect and")
+		assert.Contains(t, mutationReport.CaughtByVet[1].ProcessOutput, "redundant or")
+	}
+}
+
+func TestMainVetWithCustomExec(t *testing.T) {
+	testMain(
+		t,
+		"../../example",
+		[]string{"--exec", "../scripts/exec/test-mutated-package.sh", "--exec-vet", "./..."},
+		returnError,
+		"The go vet of --exec-vet is only executed by the built-in exec command",
+	)
+}
+
+func TestMainFunctions(t *testing.T) {
+	saveReportFileName := models.ReportFileName
+	defer func() {
+		models.ReportFileName = saveReportFileName
+	}()
+	models.ReportFileName = filepath.Join(t.TempDir(), "report.json")
+
+	testMain(
+		t,
+		"../../example",
+		[]string{"--exec-timeout", "1", "--match", "baz", "./..."},
+		returnOk,
+		"Weakest functions:\nFunction  File        Killed  Escaped  Not covered  Total  MSI\nbaz       example.go  0       5        0            5      0.00\n",
+	)
+
+	jsonData, err := os.ReadFile(models.ReportFileName)
+	assert.NoError(t, err)
+
+	var mutationReport models.Report
+	assert.NoError(t, json.Unmarshal(jsonData, &mutationReport))
+
+	if assert.Len(t, mutationReport.Functions, 2) {
+		assert.Equal(t, "example.go", mutationReport.Functions[0].File)
+		assert.Equal(t, "baz", mutationReport.Functions[0].Function)
+		assert.Equal(t, 0.0, mutationReport.Functions[0].Msi)
+		assert.Equal(t, filepath.Join("sub", "sub.go"), mutationReport.Functions[1].File)
+		assert.Equal(t, 1.0, mutationReport.Functions[1].Msi)
+	}
+	for _, mutant := range mutationReport.Escaped {
+		assert.Equal(t, "baz", mutant.Mutator.Function)
+	}
+}
+
+func TestMainSkipFilesOver(t *testing.T) {
+	saveReportFileName := models.ReportFileName
+	defer func() {
+		models.ReportFileName = saveReportFileName
+	}()
+	models.ReportFileName = filepath.Join(t.TempDir(), "report.json")
+
+	testMain(
+		t,
+		"../../example",
+		[]string{"--exec-timeout", "1", "--skip-files-over", "300B", "--skip-files-over-mutants", "2", "./..."},
+		returnOk,
+		"2 files were not mutated since they are too large: example.go, sub/sub.go",
+	)
+
+	jsonData, err := os.ReadFile(models.ReportFileName)
+	assert.NoError(t, err)
+
+	var mutationReport models.Report
+	assert.NoError(t, json.Unmarshal(jsonData, &mutationReport))
+
+	assert.Equal(t, []models.ExcludedFile{
+		{File: "example.go", Reason: models.ExcludedBySize, Size: 439},
+		{File: filepath.Join("sub", "sub.go"), Reason: models.ExcludedByMutants, Mutants: 5},
+	}, mutationReport.ExcludedFiles)
+	assert.Equal(t, int64(4), mutationReport.Stats.TotalMutantsCount)
+}
+
+func TestMainSkipFilesOverInvalidSize(t *testing.T) {
+	testMain(
+		t,
+		"../../example",
+		[]string{"--skip-files-over", "1XB", "./..."},
+		returnError,
+		`File size limit "1XB" is not valid`,
+	)
+}
+
+func TestMainExportMatrix(t *testing.T) {
+	matrixFile := filepath.Join(t.TempDir(), "matrix.csv")
+
+	testMain(
+		t,
+		"../../example",
+		[]string{"--debug", "--exec-timeout", "1", "--match", "baz", "--test-selection", "--export-matrix", matrixFile, "./..."},
+		returnOk,
+		"The mutation score is 0.500000 (5 passed, 0 failed, 0 duplicated, 0 skipped, total is 10)",
+	)
+
+	content, err := os.ReadFile(matrixFile)
+	assert.NoError(t, err)
+
+	// The mutations of the unused function of example.go are not covered by any test
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	assert.Len(t, lines, 6)
+	assert.True(t, strings.HasPrefix(lines[0], "checksum,file,line,mutator,"))
+	assert.Contains(t, lines[0], ".TestBaz")
+
+	killed := 0
+	for _, line := range lines[1:] {
+		if strings.HasSuffix(line, ",1") {
+			killed++
+		}
+	}
+	assert.Equal(t, 5, killed)
+}
+
+func TestMainExportMatrixWithoutTestSelection(t *testing.T) {
+	testMain(
+		t,
+		"../../example",
+		[]string{"--export-matrix", "matrix.csv"},
+		returnError,
+		"The kill matrix of --export-matrix needs the tests of --test-selection",
+	)
+}
+
+func TestMainLimitResources(t *testing.T) {
+	testMain(
+		t,
+		"../../example",
+		[]string{"--debug", "--exec-timeout", "1", "--match", "baz", "--exec-gomaxprocs", "1", "--exec-nice", "10", "./..."},
+		returnOk,
+		"The mutation score is 0.500000 (5 passed, 5 failed, 0 duplicated, 0 skipped, total is 10)",
+	)
+}
+
+func TestMainLimitResourcesInvalidNice(t *testing.T) {
+	testMain(
+		t,
+		"../../example",
+		[]string{"--exec-nice", "20", "./..."},
+		returnError,
+		"Nice level 20 is not in the range [0, 19]",
+	)
+}
+
+func TestMainUntestedPackage(t *testing.T) {
+	testMain(
+		t,
+		"../../testdata/untested",
+		[]string{"--exec-timeout", "1", "."},
+		returnOk,
+		"The mutation score is 0.000000 (0 passed, 0 failed, 0 duplicated, 0 skipped, total is 7)\n"+
+			"The mutations of 1 packages without test files were not executed: command-line-arguments",
+	)
+}
+
+func TestMainUntestedPackageEscaped(t *testing.T) {
+	testMain(
+		t,
+		"../../testdata/untested",
+		[]string{"--exec-timeout", "1", "--config", "../configs/configUntestedEscaped.yml.test", "."},
+		returnOk,
+		"The mutation score is 0.000000 (0 passed, 7 failed, 0 duplicated, 0 skipped, total is 7)",
+	)
+}
+
+func TestMainSuggest(t *testing.T) {
+	testMain(
+		t,
+		"../../example",
+		[]string{"suggest", "../testdata/matrix/matrix.csv"},
+		returnOk,
+		"Tests which kill no mutant on their own, each of them is a candidate for removal (1):\n  example.TestFoo\n"+
+			"Mutants which are killed by only one test, their coverage is fragile (1):\n  example.go:5 branch/if (abc) is only killed by example.TestBar\n",
+	)
+}
+
+func TestMainMinMsi(t *testing.T) {
+	testMain(
+		t,
+		"../../example",
+		[]string{"--debug", "--exec-timeout", "1", "--match", "baz", "--min-msi", "0.5", "./..."},
+		returnOk,
+		"The mutation score is 0.500000 (5 passed, 5 failed, 0 duplicated, 0 skipped, total is 10)",
+	)
+}
+
+func TestMainMinMsiFromConfig(t *testing.T) {
+	testMain(
+		t,
+		"../../example",
+		[]string{"--debug", "--exec-timeout", "1", "--match", "baz", "--config", "../testdata/configs/configMinMsi.yml.test", "./..."},
+		returnMsiBelowThreshold,
+		"The mutation score 0.500000 is below the minimum of 0.900000",
+	)
+}
+
+func TestMainPacks(t *testing.T) {
+	testMain(
+		t,
+		"../../example",
+		[]string{"--exec-timeout", "1", "--match", "baz", "--config", "../testdata/configs/configPacks.yml.test", "--packs", "numbers", "./..."},
+		returnOk,
+		"The mutation score is 0.500000 (2 passed, 2 failed, 0 duplicated, 0 skipped, total is 4)",
+	)
+}
+
+func TestMainPacksEnableOptIn(t *testing.T) {
+	testMain(
+		t,
+		"../../example",
+		[]string{"--verbose", "--no-exec", "--config", "../testdata/configs/configPacks.yml.test", "--packs", "numbers,index", "./..."},
+		returnOk,
+		`Enable mutator "expression/index"`,
+	)
+}
+
+func TestMainEnableConditionalBoundary(t *testing.T) {
+	out := testMain(
+		t,
+		"../../example",
+		[]string{"--verbose", "--no-exec", "--enable", "conditional/boundary", "./..."},
+		returnOk,
+		`Enable mutator "conditional/boundary"`,
+	)
+	assert.NotContains(t, out, `Enable mutator "conditional/negated"`)
+}
+
+func TestMainPacksUnknown(t *testing.T) {
+	testMain(
+		t,
+		"../../example",
+		[]string{"--config", "../testdata/configs/configPacks.yml.test", "--packs", "unknown", "./..."},
+		returnError,
+		`Could not enable packs: pack "unknown" contains the unknown mutator "unknown/mutator"`,
+	)
+
+	testMain(
+		t,
+		"../../example",
+		[]string{"--config", "../testdata/configs/configPacks.yml.test", "--packs", "errors", "./..."},
+		returnError,
+		`Could not enable packs: pack "errors" is not defined in the packs config parameter`,
+	)
+}
+
+func TestMainPlugins(t *testing.T) {
+	testMain(
+		t,
+		"../../example",
+		[]string{"--debug", "--exec-timeout", "1", "--config", "../testdata/configs/configPlugins.yml.test", "--packs", "plugins", "--match", "baz", "./..."},
+		returnOk,
+		"The mutation score is 0.500000 (1 passed, 1 failed, 0 duplicated, 0 skipped, total is 2)",
+	)
+}
+
+func TestMainMutatorPluginUnknown(t *testing.T) {
+	testMain(
+		t,
+		"../../example",
+		[]string{"--mutator-plugin", "unknown.so", "./..."},
+		returnError,
+		`Could not load mutator plugin "unknown.so"`,
+	)
+}
+
+func TestMainMutatorsConfig(t *testing.T) {
+	testMain(
+		t,
+		"../../example",
+		[]string{"--debug", "--exec-timeout", "1", "--config", "../testdata/configs/configMutators.yml.test", "--match", "baz", "./..."},
+		returnOk,
+		"+\ti := 11",
+	)
+
+	testMain(
+		t,
+		"../../example",
+		[]string{"--config", "../testdata/configs/configMutatorsInvalid.yml.test", "./..."},
+		returnError,
+		`Could not configure mutator "numbers/incrementer": parameter "step" must be positive but is -1`,
+	)
+}
+
+func TestMainPrecommit(t *testing.T) {
+	dir := t.TempDir()
+
+	git := func(args ...string) {
+		cmd := exec.Command("git", append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+		cmd.Dir = dir
+		output, err := cmd.CombinedOutput()
+		assert.NoError(t, err, string(output))
+	}
+	write := func(name string, content string) {
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0666))
+	}
+
+	write("go.mod", "module example.com/calc\n\ngo 1.18\n")
+	write("calc.go", "package calc\n\nfunc Add(a, b int) int {\n\treturn a + b\n}\n\nfunc Sub(a, b int) int {\n\treturn a - b\n}\n")
+	write("calc_test.go", "package calc\n\nimport \"testing\"\n\nfunc TestAdd(t *testing.T) {\n\tif Add(1, 2) != 3 {\n\t\tt.Fail()\n\t}\n}\n")
+	git("init", "-q")
+	git("add", ".")
+	git("commit", "-q", "-m", "calc")
+
+	testMain(t, dir, []string{"precommit"}, returnOk, "There are no staged changes of Go source files to mutate")
+
+	// Only the changed function is mutated and its mutations are killed
+	write("calc.go", "package calc\n\nfunc Add(a, b int) int {\n\tsum := a + b\n\n\treturn sum\n}\n\nfunc Sub(a, b int) int {\n\treturn a - b\n}\n")
+	git("add", "calc.go")
+
+	testMain(t, dir, []string{"precommit"}, returnOk, "The mutation score is 1.000000 (1 passed, 0 failed, 0 duplicated, 0 skipped, total is 1)")
+
+	// The untested function is blocked
+	write("calc.go", "package calc\n\nfunc Add(a, b int) int {\n\tsum := a + b\n\n\treturn sum\n}\n\nfunc Sub(a, b int) int {\n\tdiff := a - b\n\n\treturn diff\n}\n")
+	git("add", "calc.go")
+
+	testMain(t, dir, []string{"precommit"}, returnEscaped, "The execution was stopped after the first escaped mutation")
+}
+
+func TestMainStrictNewCode(t *testing.T) {
+	dir := t.TempDir()
+
+	git := func(args ...string) {
+		cmd := exec.Command("git", append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+		cmd.Dir = dir
+		output, err := cmd.CombinedOutput()
+		assert.NoError(t, err, string(output))
+	}
+	write := func(name string, content string) {
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0666))
+	}
+
+	write("go.mod", "module example.com/calc\n\ngo 1.18\n")
+	write("calc.go", "package calc\n\nfunc Add(a, b int) int {\n\treturn a + b\n}\n\nfunc Sub(a, b int) int {\n\treturn a - b\n}\n")
+	write("calc_test.go", "package calc\n\nimport \"testing\"\n\nfunc TestAdd(t *testing.T) {\n\tif Add(1, 2) != 3 {\n\t\tt.Fail()\n\t}\n}\n")
+	git("init", "-q")
+	git("add", ".")
+	git("commit", "-q", "-m", "calc")
+
+	// The escaped mutations of the untested legacy function only lower the score
+	testMain(t, dir, []string{"--strict-new-code", "HEAD", "./..."}, returnOk, `0 of the 2 escaped mutations are in code which changed compared to "HEAD"`)
+
+	// The escaped mutations of the untested new function fail the run
+	write("calc.go", "package calc\n\nfunc Add(a, b int) int {\n\treturn a + b\n}\n\nfunc Sub(a, b int) int {\n\treturn a - b\n}\n\nfunc Mul(a, b int) int {\n\treturn a * b\n}\n")
+
+	testMain(t, dir, []string{"--strict-new-code", "HEAD", "./..."}, returnEscaped, `2 mutations of code which changed compared to "HEAD" escaped`)
+
+	testMain(t, dir, []string{"--strict-new-code", "unknown", "./..."}, returnError, `Could not get changes of new code compared to "unknown"`)
+}
+
+func TestMainSampleRate(t *testing.T) {
+	testMain(
+		t,
+		"../../example",
+		[]string{"--debug", "--exec-timeout", "1", "--match", "baz", "--sample-rate", "0.5", "--seed", "1", "./..."},
+		returnOk,
+		"The mutation score is 0.500000 (2 passed, 2 failed, 0 duplicated, 0 skipped, total is 4)",
+	)
+}
+
+func TestMainSampleRateInvalid(t *testing.T) {
+	testMain(
+		t,
+		"../../example",
+		[]string{"--sample-rate", "1.5"},
+		returnError,
+		"Sample rate 1.5 is not in the range (0, 1]",
 	)
 }
 
@@ -59,7 +795,7 @@ func TestMainSkipWithoutTest(t *testing.T) {
 		"../../example",
 		[]string{"--debug", "--exec-timeout", "1", "--config", "../testdata/configs/configSkipWithoutTest.yml.test"},
 		returnOk,
-		"The mutation score is 0.583333 (35 passed, 25 failed, 8 duplicated, 0 skipped, total is 60)",
+		"The mutation score is 0.597222 (43 passed, 29 failed, 9 duplicated, 0 skipped, total is 72)",
 	)
 }
 
@@ -81,7 +817,7 @@ func TestMainJSONReport(t *testing.T) {
 		"../../example",
 		[]string{"--debug", "--exec-timeout", "1", "--config", "../testdata/configs/configForJson.yml.test"},
 		returnOk,
-		"The mutation score is 0.583333 (35 passed, 25 failed, 8 duplicated, 0 skipped, total is 60)",
+		"The mutation score is 0.597222 (43 passed, 29 failed, 9 duplicated, 0 skipped, total is 72)",
 	)
 
 	info, err := os.Stat(jsonFile)
@@ -103,23 +839,23 @@ func TestMainJSONReport(t *testing.T) {
 	assert.NoError(t, err)
 
 	expectedStats := models.Stats{
-		TotalMutantsCount:    60,
-		KilledCount:          35,
+		TotalMutantsCount:    72,
+		KilledCount:          43,
 		NotCoveredCount:      0,
-		EscapedCount:         25,
+		EscapedCount:         29,
 		ErrorCount:           0,
 		SkippedCount:         0,
 		TimeOutCount:         0,
-		Msi:                  0.5833333333333334,
+		Msi:                  0.5972222222222222,
 		MutationCodeCoverage: 0,
 		CoveredCodeMsi:       0,
 		DuplicatedCount:      0,
 	}
 
 	assert.Equal(t, expectedStats, mutationReport.Stats)
-	assert.Equal(t, 25, len(mutationReport.Escaped))
+	assert.Equal(t, 29, len(mutationReport.Escaped))
 	assert.Nil(t, mutationReport.Timeouted)
-	assert.Equal(t, 35, len(mutationReport.Killed))
+	assert.Equal(t, 43, len(mutationReport.Killed))
 	assert.Nil(t, mutationReport.Errored)
 
 	for i := 0; i < len(mutationReport.Escaped); i++ {
@@ -130,7 +866,74 @@ func TestMainJSONReport(t *testing.T) {
 	}
 }
 
-func testMain(t *testing.T, root string, exec []string, expectedExitCode int, contains string) {
+func TestMainProgressFile(t *testing.T) {
+	progressFile := filepath.Join(t.TempDir(), "progress.json")
+
+	testMain(
+		t,
+		"../../example",
+		[]string{"--progress-file", progressFile, "--match", "baz", "./..."},
+		returnOk,
+		"The mutation score is 0.500000 (5 passed, 5 failed, 0 duplicated, 0 skipped, total is 10)",
+	)
+
+	content, err := os.ReadFile(progressFile)
+	assert.NoError(t, err)
+
+	var p struct {
+		Files        int    `json:"files"`
+		MutatedFiles int    `json:"mutatedFiles"`
+		Total        int    `json:"total"`
+		Executed     int    `json:"executed"`
+		Killed       int64  `json:"killed"`
+		Escaped      int64  `json:"escaped"`
+		CurrentFile  string `json:"currentFile"`
+		ETA          int64  `json:"etaSeconds"`
+		Done         bool   `json:"done"`
+	}
+	assert.NoError(t, json.Unmarshal(content, &p))
+	assert.True(t, p.Done)
+	assert.Equal(t, p.Files, p.MutatedFiles)
+	assert.Equal(t, 10, p.Total)
+	assert.Equal(t, 10, p.Executed)
+	assert.Equal(t, int64(5), p.Killed)
+	assert.Equal(t, int64(5), p.Escaped)
+	assert.Empty(t, p.CurrentFile)
+	assert.Equal(t, int64(0), p.ETA)
+}
+
+func TestMainMutate(t *testing.T) {
+	out := t.TempDir()
+
+	testMain(
+		t,
+		"../../example",
+		[]string{"mutate", "--out", out, "--match", "baz", "./..."},
+		returnOk,
+		fmt.Sprintf("Saved 10 patches into %q", out),
+	)
+
+	content, err := os.ReadFile(filepath.Join(out, "index.json"))
+	assert.NoError(t, err)
+
+	var entries []struct {
+		Patch    string `json:"patch"`
+		Checksum string `json:"checksum"`
+	}
+	assert.NoError(t, json.Unmarshal(content, &entries))
+	assert.Len(t, entries, 10)
+
+	for _, entry := range entries {
+		assert.Equal(t, entry.Checksum+".patch", entry.Patch)
+
+		apply := exec.Command("git", "apply", "--check", filepath.Join(out, entry.Patch))
+		apply.Dir = "../../example"
+		output, err := apply.CombinedOutput()
+		assert.NoError(t, err, string(output))
+	}
+}
+
+func testMain(t *testing.T, root string, exec []string, expectedExitCode int, contains string) string {
 	saveStderr := os.Stderr
 	saveStdout := os.Stdout
 	saveCwd, err := os.Getwd()
@@ -166,4 +969,6 @@ func testMain(t *testing.T, root string, exec []string, expectedExitCode int, co
 
 	assert.Equal(t, expectedExitCode, exitCode)
 	assert.Contains(t, out, contains)
+
+	return out
 }
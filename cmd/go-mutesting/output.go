@@ -0,0 +1,76 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/VirtualRoyalty/go-mutesting/internal/models"
+)
+
+// reportOutput is a file into which the report is written in a format.
+type reportOutput struct {
+	format string
+	path   string
+}
+
+// reportOutputs returns the files of --output, the formats of --report-format and the sarif_output config parameter are written into their default files unless --output has a file for them.
+// Without --output the JSON report is written into its default file unless it is written to the standard output with --json.
+func reportOutputs(opts *models.Options) ([]reportOutput, error) {
+	var outputs []reportOutput
+	formats := map[string]bool{}
+
+	for _, output := range opts.Report.Outputs {
+		o := reportOutput{
+			format: "json",
+			path:   output,
+		}
+
+		// Paths can contain "=" as well, but formats are never paths
+		if format, path, ok := strings.Cut(output, "="); ok && !strings.ContainsAny(format, `./\`) {
+			switch format {
+			case "json", "badge", "html", "markdown", "sarif":
+			default:
+				return nil, fmt.Errorf("Unknown format %q of output %q", format, output)
+			}
+
+			o.format = format
+			o.path = path
+		}
+
+		if o.path == "" {
+			return nil, fmt.Errorf("Output %q has no file", output)
+		}
+
+		outputs = append(outputs, o)
+		formats[o.format] = true
+	}
+
+	defaults := []reportOutput{}
+	if len(opts.Report.Outputs) == 0 && !opts.General.JSON {
+		defaults = append(defaults, reportOutput{format: "json", path: models.ReportFileName})
+	}
+	if opts.Config.SarifOutput {
+		defaults = append(defaults, reportOutput{format: "sarif", path: models.SarifReportFileName})
+	}
+	for _, format := range opts.Report.Formats {
+		switch format {
+		case "badge":
+			defaults = append(defaults, reportOutput{format: format, path: models.BadgeReportFileName})
+		case "html":
+			defaults = append(defaults, reportOutput{format: format, path: models.HTMLReportFileName})
+		case "markdown":
+			defaults = append(defaults, reportOutput{format: format, path: models.MarkdownReportFileName})
+		case "sarif":
+			defaults = append(defaults, reportOutput{format: format, path: models.SarifReportFileName})
+		}
+	}
+
+	for _, o := range defaults {
+		if !formats[o.format] {
+			outputs = append(outputs, o)
+			formats[o.format] = true
+		}
+	}
+
+	return outputs, nil
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/VirtualRoyalty/go-mutesting/internal/models"
+)
+
+func TestReportOutputs(t *testing.T) {
+	opts := &models.Options{}
+
+	outputs, err := reportOutputs(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, []reportOutput{{format: "json", path: models.ReportFileName}}, outputs)
+
+	opts.General.JSON = true
+
+	outputs, err = reportOutputs(opts)
+	assert.NoError(t, err)
+	assert.Empty(t, outputs)
+
+	opts.General.JSON = false
+	opts.Report.Outputs = []string{"build/mutation.json", "html=build/report.html", "sarif=build/a=b.sarif", "build/x=y.json"}
+	opts.Report.Formats = []string{"markdown", "sarif", "badge"}
+	opts.Config.SarifOutput = true
+
+	outputs, err = reportOutputs(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, []reportOutput{
+		{format: "json", path: "build/mutation.json"},
+		{format: "html", path: "build/report.html"},
+		{format: "sarif", path: "build/a=b.sarif"},
+		{format: "json", path: "build/x=y.json"},
+		{format: "markdown", path: models.MarkdownReportFileName},
+		{format: "badge", path: models.BadgeReportFileName},
+	}, outputs)
+
+	for output, message := range map[string]string{
+		"pdf=report.pdf": `Unknown format "pdf" of output "pdf=report.pdf"`,
+		"html=":          `Output "html=" has no file`,
+		"":               `Output "" has no file`,
+	} {
+		opts.Report.Outputs = []string{output}
+
+		_, err = reportOutputs(opts)
+		assert.EqualError(t, err, message, output)
+	}
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/jessevdk/go-flags"
+
+	"github.com/VirtualRoyalty/go-mutesting/internal/models"
+	"github.com/VirtualRoyalty/go-mutesting/report"
+)
+
+// renderOptions are the arguments of the report render command.
+type renderOptions struct {
+	Format string `long:"format" description:"Format into which the report is rendered" choice:"badge" choice:"html" choice:"md" choice:"sarif" required:"true"`
+	Out    string `long:"out" description:"Write the rendered report into this file instead of the standard output"`
+}
+
+// reportCmd executes the subcommands of the report command for existing JSON reports.
+func reportCmd(args []string) int {
+	if len(args) == 0 || args[0] != "render" {
+		return exitError("Usage: go-mutesting report render <JSON report file> --format badge|html|md|sarif [--out <file>]")
+	}
+
+	return renderCmd(args[1:])
+}
+
+// renderCmd renders a JSON report in another format without executing any mutations.
+func renderCmd(args []string) int {
+	var opts renderOptions
+
+	args, err := flags.NewParser(&opts, flags.None).ParseArgs(args)
+	if err != nil {
+		return exitError(err.Error())
+	} else if len(args) != 1 {
+		return exitError("Usage: go-mutesting report render <JSON report file> --format badge|html|md|sarif [--out <file>]")
+	}
+
+	loaded, err := report.Load(args[0])
+	if err != nil {
+		return exitError("Could not read report %q: %v", args[0], err)
+	}
+
+	content, err := renderReport(loaded, opts.Format)
+	if err != nil {
+		return exitError("Could not render report %q: %v", args[0], err)
+	}
+
+	if opts.Out == "" {
+		_, err = os.Stdout.Write(content)
+	} else {
+		err = saveReport(opts.Out, content)
+	}
+	if err != nil {
+		return exitError(err.Error())
+	}
+
+	return returnOk
+}
+
+// renderReport returns the content of the report in the given format.
+func renderReport(r *models.Report, format string) ([]byte, error) {
+	switch format {
+	case "json":
+		return json.Marshal(r)
+	case "badge":
+		return json.Marshal(r.Badge())
+	case "html":
+		content, err := r.HTML()
+
+		return []byte(content), err
+	case "md", "markdown":
+		return []byte(r.Markdown()), nil
+	case "sarif":
+		return json.MarshalIndent(r.Sarif(), "", "  ")
+	}
+
+	return nil, fmt.Errorf("unknown format %q", format)
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/VirtualRoyalty/go-mutesting/internal/models"
+	"github.com/VirtualRoyalty/go-mutesting/report"
+)
+
+func TestReportRender(t *testing.T) {
+	dir := t.TempDir()
+	reportFile := filepath.Join(dir, "report.json")
+
+	r := &models.Report{
+		Escaped: []models.Mutant{
+			{
+				Mutator: models.Mutator{
+					MutatorName:       "branch/if",
+					OriginalFilePath:  "example/example.go",
+					OriginalStartLine: 12,
+				},
+				Diff: "--- Original\n+++ New\n-\tfoo()\n+\t_ = foo\n",
+			},
+		},
+	}
+	r.File("example/example.go").KilledCount = 3
+	r.File("example/example.go").EscapedCount = 1
+	r.Stats = models.Stats{
+		KilledCount:  3,
+		EscapedCount: 1,
+	}
+	r.Calculate()
+	assert.NoError(t, report.Save(reportFile, r))
+
+	testMain(t, ".", []string{"report", "render", reportFile, "--format", "md"}, returnOk, "**Mutation score: 0.75**")
+	testMain(t, ".", []string{"report", "render", reportFile, "--format", "sarif"}, returnOk, `"ruleId": "branch/if"`)
+	testMain(t, ".", []string{"report", "render", reportFile, "--format", "badge"}, returnOk, `"message":"75.0%","color":"yellow"`)
+
+	out := filepath.Join(dir, "report.html")
+	testMain(t, ".", []string{"report", "render", reportFile, "--format", "html", "--out", out}, returnOk, "")
+
+	content, err := os.ReadFile(out)
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), "<code>example/example.go:12</code>")
+
+	testMain(t, ".", []string{"report", "render", reportFile}, returnError, "--format")
+	testMain(t, ".", []string{"report", "render", reportFile, "--format", "pdf"}, returnError, "Invalid value")
+	testMain(t, ".", []string{"report", "render", filepath.Join(dir, "missing.json"), "--format", "md"}, returnError, "Could not read report")
+	testMain(t, ".", []string{"report"}, returnError, "Usage: go-mutesting report render")
+}
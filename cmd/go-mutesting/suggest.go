@@ -0,0 +1,48 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/VirtualRoyalty/go-mutesting/internal/models"
+)
+
+// suggestCmd prints suggestions for the maintenance of the test suite derived from a kill matrix of --export-matrix.
+func suggestCmd(args []string) int {
+	if len(args) != 1 {
+		return exitError("Usage: go-mutesting suggest <kill matrix CSV file>")
+	}
+
+	file, err := os.Open(args[0])
+	if err != nil {
+		return exitError("Could not open kill matrix %q: %v", args[0], err)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	matrix, err := models.ReadKillMatrixCSV(file)
+	if err != nil {
+		return exitError("Could not read kill matrix %q: %v", args[0], err)
+	}
+
+	suggestions := matrix.Suggest()
+
+	fmt.Printf("Tests which kill no mutant on their own, each of them is a candidate for removal (%d):\n", len(suggestions.RedundantTests))
+	for _, test := range suggestions.RedundantTests {
+		fmt.Printf("  %s\n", test)
+	}
+
+	fmt.Printf("Mutants which are killed by only one test, their coverage is fragile (%d):\n", len(suggestions.FragileMutants))
+	for _, m := range suggestions.FragileMutants {
+		fmt.Printf("  %s:%d %s (%s) is only killed by %s\n",
+			m.Mutant.Mutator.OriginalFilePath,
+			m.Mutant.Mutator.OriginalStartLine,
+			m.Mutant.Mutator.MutatorName,
+			m.Checksum,
+			m.Test,
+		)
+	}
+
+	return returnOk
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/VirtualRoyalty/go-mutesting/astutil"
+	"github.com/VirtualRoyalty/go-mutesting/internal/models"
+	"github.com/VirtualRoyalty/go-mutesting/report"
+)
+
+// todoTestFileSuffix replaces the ".go" suffix of a mutated file for the file of its test skeletons.
+const todoTestFileSuffix = "_mutation_todo_test.go"
+
+// suggestTestsCmd writes a test skeleton for every escaped mutant of a JSON report next to the mutated file.
+func suggestTestsCmd(args []string) int {
+	if len(args) != 1 {
+		return exitError("Usage: go-mutesting suggest-tests <JSON report file>")
+	}
+
+	loaded, err := report.Load(args[0])
+	if err != nil {
+		return exitError("Could not read report %q: %v", args[0], err)
+	}
+
+	files, err := testSkeletons(loaded.Escaped)
+	if err != nil {
+		return exitError(err.Error())
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		err = os.WriteFile(name, files[name], 0666)
+		if err != nil {
+			return exitError("Could not write test skeletons %q: %v", name, err)
+		}
+
+		fmt.Printf("Wrote test skeletons into %q\n", name)
+	}
+
+	fmt.Printf("Wrote %d test skeletons for escaped mutants into %d files\n", len(loaded.Escaped), len(files))
+
+	return returnOk
+}
+
+// testSkeletons returns the content of the test skeleton files for the given escaped mutants by their file names.
+func testSkeletons(mutants []models.Mutant) (map[string][]byte, error) {
+	byFile := map[string][]models.Mutant{}
+	var files []string
+	for _, mutant := range mutants {
+		file := mutant.Mutator.OriginalFilePath
+		if _, ok := byFile[file]; !ok {
+			files = append(files, file)
+		}
+		byFile[file] = append(byFile[file], mutant)
+	}
+
+	skeletons := map[string][]byte{}
+	for _, file := range files {
+		fset := token.NewFileSet()
+		src, err := parser.ParseFile(fset, file, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse mutated file %q: %v", file, err)
+		}
+
+		var b strings.Builder
+		fmt.Fprintf(&b, "package %s\n\nimport \"testing\"\n", src.Name.Name)
+
+		names := map[string]int{}
+		for _, mutant := range byFile[file] {
+			target, name := enclosingFunc(fset, src, int(mutant.Mutator.OriginalStartLine))
+
+			names[name]++
+			testName := fmt.Sprintf("Test%s_Mutation%d", name, names[name])
+
+			fmt.Fprintf(&b, "\n// %s should kill the escaped mutant of the %s mutator which changed %s at line %d of %s.\n",
+				testName, mutant.Mutator.MutatorName, target, mutant.Mutator.OriginalStartLine, file)
+			b.WriteString("//\n// The mutant is:\n//\n")
+			for _, line := range strings.Split(strings.TrimRight(mutant.Diff, "\n"), "\n") {
+				fmt.Fprintf(&b, "//\t%s\n", line)
+			}
+			fmt.Fprintf(&b, "func %s(t *testing.T) {\n\tt.Skip(\"TODO: write a test which fails for this mutant\")\n}\n", testName)
+		}
+
+		content, err := format.Source([]byte(b.String()))
+		if err != nil {
+			return nil, fmt.Errorf("could not format test skeletons of %q: %v", file, err)
+		}
+
+		skeletons[strings.TrimSuffix(file, ".go")+todoTestFileSuffix] = content
+	}
+
+	return skeletons, nil
+}
+
+// enclosingFunc returns a description and a test name part of the function which contains the given line.
+func enclosingFunc(fset *token.FileSet, file *ast.File, line int) (string, string) {
+	f, recv := astutil.FuncDeclAt(fset, file, line)
+	if f == nil {
+		return "package level code", "Package"
+	} else if recv != "" {
+		return fmt.Sprintf("the method %s.%s", recv, f.Name.Name), exportedName(recv) + "_" + exportedName(f.Name.Name)
+	}
+
+	return fmt.Sprintf("the function %s", f.Name.Name), exportedName(f.Name.Name)
+}
+
+// exportedName returns the name with an upper case first letter so it can follow the "Test" prefix.
+func exportedName(name string) string {
+	return strings.ToUpper(name[:1]) + name[1:]
+}
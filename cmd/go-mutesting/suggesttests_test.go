@@ -0,0 +1,51 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/VirtualRoyalty/go-mutesting/internal/models"
+)
+
+func TestTestSkeletons(t *testing.T) {
+	diff := "--- Original\n+++ New\n@@ -15,7 +15,7 @@\n \t\tn++\n \t}\n \n-\tif n < 0 {\n+\tif n <= 0 {\n \t\tn = 0\n \t}\n"
+
+	files, err := testSkeletons([]models.Mutant{
+		{
+			Mutator: models.Mutator{
+				MutatorName:       "conditional/negated",
+				OriginalFilePath:  "../../example/example.go",
+				OriginalStartLine: 18,
+			},
+			Diff: diff,
+		},
+		{
+			Mutator: models.Mutator{
+				MutatorName:       "branch/if",
+				OriginalFilePath:  "../../example/example.go",
+				OriginalStartLine: 19,
+			},
+			Diff: diff,
+		},
+	})
+	assert.NoError(t, err)
+
+	content, ok := files["../../example/example_mutation_todo_test.go"]
+	assert.True(t, ok)
+	assert.Contains(t, string(content), "package example\n")
+	assert.Contains(t, string(content), ""+
+		"// TestFoo_Mutation1 should kill the escaped mutant of the conditional/negated mutator which changed the function foo at line 18 of ../../example/example.go.\n"+
+		"//\n"+
+		"// The mutant is:\n"+
+		"//\n"+
+		"//\t--- Original\n"+
+		"//\t+++ New\n")
+	assert.Contains(t, string(content), "//\t+\tif n <= 0 {\n"+
+		"//\t \t\tn = 0\n"+
+		"//\t \t}\n"+
+		"func TestFoo_Mutation1(t *testing.T) {\n"+
+		"\tt.Skip(\"TODO: write a test which fails for this mutant\")\n"+
+		"}\n")
+	assert.Contains(t, string(content), "func TestFoo_Mutation2(t *testing.T) {\n")
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/VirtualRoyalty/go-mutesting/report"
+)
+
+// trendShortCommit is the count of characters of the commits which are printed.
+const trendShortCommit = 8
+
+// trendCmd prints the mutation score of every run of a history file with its change compared to the previous run.
+func trendCmd(args []string) int {
+	if len(args) != 1 {
+		return exitError("Usage: go-mutesting trend <history file>")
+	}
+
+	entries, err := report.LoadHistory(args[0])
+	if err != nil {
+		return exitError("Could not read history file %q: %v", args[0], err)
+	} else if len(entries) == 0 {
+		return exitError("History file %q has no runs", args[0])
+	}
+
+	for i, entry := range entries {
+		commit := entry.Commit
+		if len(commit) > trendShortCommit {
+			commit = commit[:trendShortCommit]
+		} else if commit == "" {
+			commit = "-"
+		}
+
+		delta := ""
+		if i > 0 {
+			delta = fmt.Sprintf(" (%+f)", entry.Msi-entries[i-1].Msi)
+		}
+
+		name := ""
+		if entry.RunName != "" {
+			name = " " + entry.RunName
+		}
+
+		fmt.Printf("%s %s%s: %f%s, %d killed, %d escaped, total is %d\n",
+			entry.Time.Format("2006-01-02 15:04:05"),
+			commit,
+			name,
+			entry.Msi,
+			delta,
+			entry.KilledCount,
+			entry.EscapedCount,
+			entry.TotalCount,
+		)
+	}
+
+	first, last := entries[0], entries[len(entries)-1]
+	fmt.Printf("The mutation score changed by %+f from %f to %f over %d runs\n", last.Msi-first.Msi, first.Msi, last.Msi, len(entries))
+
+	return returnOk
+}
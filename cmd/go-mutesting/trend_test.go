@@ -0,0 +1,54 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/VirtualRoyalty/go-mutesting/internal/models"
+)
+
+func TestTrend(t *testing.T) {
+	saveReportFileName := models.ReportFileName
+	defer func() {
+		models.ReportFileName = saveReportFileName
+	}()
+	models.ReportFileName = filepath.Join(t.TempDir(), "report.json")
+
+	historyFile := filepath.Join(t.TempDir(), "msi-history.json")
+
+	testMain(
+		t,
+		"../../example",
+		[]string{"--match", "baz", "--run-name", "nightly", "--history-file", historyFile, "./..."},
+		returnOk,
+		"",
+	)
+
+	entries, err := models.ReadHistory(historyFile)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+	assert.Equal(t, "nightly", entries[0].RunName)
+	assert.NotZero(t, entries[0].TotalCount)
+
+	// Runs with --no-exec have no mutation score which could be recorded
+	testMain(t, "../../example", []string{"--no-exec", "--match", "baz", "--history-file", historyFile, "./..."}, returnOk, "")
+
+	assert.NoError(t, models.AppendHistory(historyFile, models.HistoryEntry{
+		Time:         time.Date(2024, 6, 2, 10, 0, 0, 0, time.UTC),
+		Commit:       "0123456789abcdef",
+		Msi:          1,
+		KilledCount:  8,
+		TotalCount:   8,
+		EscapedCount: 0,
+	}))
+
+	out := testMain(t, ".", []string{"trend", historyFile}, returnOk, "2024-06-02 10:00:00 01234567: 1.000000 (+")
+	assert.Contains(t, out, " nightly: ")
+	assert.Contains(t, out, "over 2 runs")
+
+	testMain(t, ".", []string{"trend"}, returnError, "Usage: go-mutesting trend")
+	testMain(t, ".", []string{"trend", filepath.Join(t.TempDir(), "missing.json")}, returnError, "has no runs")
+}
@@ -0,0 +1,34 @@
+package mutesting
+
+import (
+	"fmt"
+
+	"github.com/VirtualRoyalty/go-mutesting/internal/console"
+	"github.com/VirtualRoyalty/go-mutesting/internal/models"
+	"github.com/VirtualRoyalty/go-mutesting/internal/parser"
+)
+
+// dryRunPrinter prints every mutation which would be executed instead of writing and executing it.
+type dryRunPrinter struct {
+	console *console.Console
+	count   int
+}
+
+// print prints the position, the mutator and the first changed line of the mutation.
+func (p *dryRunPrinter) print(mutant models.Mutant, originalSourceCode []byte, mutatedSourceCode []byte) {
+	p.count++
+
+	original, mutated := parser.FirstChange(originalSourceCode, mutatedSourceCode)
+
+	var description string
+	switch {
+	case original == "":
+		description = fmt.Sprintf("adds %q", mutated)
+	case mutated == "":
+		description = fmt.Sprintf("removes %q", original)
+	default:
+		description = fmt.Sprintf("%q -> %q", original, mutated)
+	}
+
+	p.console.Message("%s:%d:%d %s %s", mutant.Mutator.OriginalFilePath, mutant.Mutator.OriginalStartLine, mutant.Mutator.OriginalStartColumn, mutant.Mutator.MutatorName, description)
+}
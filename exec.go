@@ -0,0 +1,546 @@
+package mutesting
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/printer"
+	"go/token"
+	"go/types"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/VirtualRoyalty/osutil"
+
+	"github.com/VirtualRoyalty/go-mutesting/internal/console"
+	"github.com/VirtualRoyalty/go-mutesting/internal/models"
+	"github.com/VirtualRoyalty/go-mutesting/internal/parser"
+	"github.com/VirtualRoyalty/go-mutesting/internal/testbin"
+)
+
+// execTimeoutGrace is added to the timeout of custom exec commands before they are killed.
+const execTimeoutGrace = 30 * time.Second
+
+// collectResult records the result of a mutation in the report and returns the mutant with its verdict.
+func collectResult(opts *models.Options, c *console.Console, stats *models.Report, result mutantResult) (models.Mutant, Verdict, error) {
+	if result.duplicate {
+		stats.Duplicates = append(stats.Duplicates, result.duplicateOf)
+		stats.Stats.DuplicatedCount++
+		stats.File(result.job.originalFile).DuplicatedCount++
+
+		mutant := models.Mutant{}
+		mutant.Mutator.ID = result.duplicateOf.ID
+		mutant.Mutator.MutatorName = result.duplicateOf.MutatorName
+		mutant.Mutator.OriginalFilePath = result.duplicateOf.OriginalFilePath
+		mutant.Mutator.OriginalStartLine = result.duplicateOf.OriginalStartLine
+		mutant.Mutator.OriginalStartColumn = result.duplicateOf.OriginalStartColumn
+
+		return mutant, VerdictDuplicated, nil
+	} else if result.notCovered {
+		stats.NotCovered = append(stats.NotCovered, result.job.mutant)
+		stats.Stats.NotCoveredCount++
+		stats.File(result.job.originalFile).NotCoveredCount++
+		stats.Function(result.job.originalFile, result.job.mutant.Mutator.Function).NotCoveredCount++
+
+		return result.job.mutant, VerdictNotCovered, nil
+	}
+
+	mutant := result.job.mutant
+	mutationFile := result.job.mutationFile
+	originalFile := result.job.originalFile
+	execExitCode := result.execExitCode
+
+	if result.builtin {
+		if opts.General.Debug {
+			c.Message("%s", result.output)
+		}
+
+		switch execExitCode {
+		case 1: // Tests passed -> FAIL
+			if !opts.Config.SilentMode {
+				c.Diff(result.diff)
+			}
+		case 0: // Tests failed -> PASS
+			if opts.General.Debug {
+				c.Diff(result.diff)
+			}
+		case 2: // Did not compile -> SKIP
+			if opts.General.Verbose {
+				c.Message("Mutation did not compile")
+			}
+
+			if opts.General.Debug {
+				c.Diff(result.diff)
+			}
+		default: // Unknown exit code -> SKIP
+			if !opts.Config.SilentMode {
+				c.Message("Unknown exit code")
+				c.Diff(result.diff)
+			}
+		}
+	} else if len(result.output) > 0 {
+		c.Output(result.output)
+	}
+
+	c.Debug("Exited with %d", execExitCode)
+
+	mutatedSourceCode, err := os.ReadFile(mutationFile)
+	if err != nil {
+		return models.Mutant{}, VerdictError, fmt.Errorf("Could not read mutation %q: %v", mutationFile, err)
+	}
+	mutant.Mutator.MutatedSourceCode = string(mutatedSourceCode)
+
+	msg := fmt.Sprintf("%q with checksum %s and ID %s", mutationFile, result.job.checksum, mutant.Mutator.ID)
+
+	if result.vet {
+		out := fmt.Sprintf("VET %s\n", msg)
+		if !opts.Config.SilentMode {
+			c.Result(console.VET, out)
+		}
+
+		mutant.ProcessOutput = string(result.output)
+		stats.CaughtByVet = append(stats.CaughtByVet, mutant)
+		stats.Stats.CaughtByVetCount++
+		stats.File(originalFile).CaughtByVetCount++
+		stats.Function(originalFile, mutant.Mutator.Function).CaughtByVetCount++
+
+		return mutant, VerdictCaughtByVet, nil
+	}
+
+	if result.timeout {
+		out := fmt.Sprintf("TIMEOUT %s\n", msg)
+		if !opts.Config.SilentMode {
+			c.Result(console.TIMEOUT, out)
+		}
+
+		mutant.ProcessOutput = out
+		stats.Timeouted = append(stats.Timeouted, mutant)
+		stats.Stats.TimeOutCount++
+		stats.File(originalFile).TimeOutCount++
+		stats.Function(originalFile, mutant.Mutator.Function).TimeOutCount++
+
+		return mutant, VerdictTimeout, nil
+	}
+
+	switch execExitCode {
+	case 0: // Tests failed - all ok
+		out := fmt.Sprintf("PASS %s\n", msg)
+		if !opts.Config.SilentMode {
+			c.Result(console.PASS, out)
+		}
+
+		mutant.ProcessOutput = out
+		stats.Killed = append(stats.Killed, mutant)
+		stats.Stats.KilledCount++
+		stats.File(originalFile).KilledCount++
+		stats.Function(originalFile, mutant.Mutator.Function).KilledCount++
+
+		return mutant, VerdictKilled, nil
+	case 1: // Tests passed
+		out := fmt.Sprintf("FAIL %s\n", msg)
+		if !opts.Config.SilentMode {
+			c.Result(console.FAIL, out)
+		}
+
+		mutant.ProcessOutput = out
+		stats.Escaped = append(stats.Escaped, mutant)
+		stats.Stats.EscapedCount++
+		stats.File(originalFile).EscapedCount++
+		stats.Function(originalFile, mutant.Mutator.Function).EscapedCount++
+
+		return mutant, VerdictEscaped, nil
+	case 2: // Did not compile
+		out := fmt.Sprintf("SKIP %s\n", msg)
+		if !opts.Config.SilentMode {
+			c.Result(console.SKIP, out)
+		}
+
+		mutant.ProcessOutput = out
+		stats.Stats.SkippedCount++
+		stats.File(originalFile).SkippedCount++
+		stats.Function(originalFile, mutant.Mutator.Function).SkippedCount++
+
+		return mutant, VerdictSkipped, nil
+	default:
+		out := fmt.Sprintf("UNKOWN exit code for %s\n", msg)
+		if !opts.Config.SilentMode {
+			c.Result(console.UNKNOWN, out)
+		}
+
+		mutant.ProcessOutput = out
+		stats.Errored = append(stats.Errored, mutant)
+		stats.Stats.ErrorCount++
+		stats.File(originalFile).ErrorCount++
+		stats.Function(originalFile, mutant.Mutator.Function).ErrorCount++
+
+		return mutant, VerdictError, nil
+	}
+}
+
+// mutateExec executes the tests for the given mutation,
+// the workspace defines the copy of the module in which the original file is replaced or is nil to replace the original file itself.
+func mutateExec(
+	ctx context.Context,
+	opts *models.Options,
+	c *console.Console,
+	pkg *types.Package,
+	file string,
+	mutationFile string,
+	execs []string,
+	mutant *models.Mutant,
+	run string,
+	ws *workspace,
+	testBinaries *testbin.Runner,
+) (result mutantResult, err error) {
+	target := file
+	if ws != nil {
+		target, err = ws.path(file)
+		if err != nil {
+			return result, err
+		}
+	}
+
+	if len(execs) == 0 {
+		c.Debug("Execute built-in exec command for mutation")
+
+		result.builtin = true
+
+		diff, err := diffMutation(file, mutationFile, "Original", "New")
+		if err != nil {
+			return result, fmt.Errorf("Could not diff mutation %q: %v", mutationFile, err)
+		}
+
+		result.diff = diff
+		mutant.Diff = string(diff)
+
+		if opts.Exec.Vet {
+			result.vet, result.output, err = vetMutation(ctx, opts, pkg, file, target, mutationFile, ws)
+			if err != nil || result.vet {
+				return result, err
+			}
+		}
+
+		result.execExitCode, result.output, err = builtinTest(ctx, opts, pkg, file, target, mutationFile, run, ws, testBinaries)
+		if err != nil {
+			return result, err
+		}
+
+		switch result.execExitCode {
+		case 0: // Tests passed -> FAIL
+			result.execExitCode = 1
+		case 1: // Tests failed -> PASS
+			result.execExitCode = 0
+		}
+
+		return result, nil
+	}
+
+	c.Debug("Execute %q for mutation", opts.Exec.Exec)
+
+	// The exec command usually has to build the tests first, so it gets a grace period on top of the timeout
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(opts.Exec.Timeout)*time.Second+execTimeoutGrace)
+	defer cancel()
+
+	execCommand := exec.CommandContext(ctx, execs[0], execs[1:]...)
+	setProcessGroup(execCommand)
+	execCommand.Cancel = func() error {
+		return killProcessGroup(execCommand)
+	}
+
+	var output bytes.Buffer
+	if ws != nil {
+		// Serialize the output of concurrently executed mutations
+		execCommand.Stderr = &output
+		execCommand.Stdout = &output
+		execCommand.Dir = ws.root
+	} else {
+		execCommand.Stderr = os.Stderr
+		execCommand.Stdout = os.Stdout
+		if opts.General.JSON {
+			// The standard output is reserved for the report
+			execCommand.Stdout = os.Stderr
+		}
+	}
+
+	execCommand.Env = append(os.Environ(), []string{
+		"MUTATE_CHANGED=" + mutationFile,
+		fmt.Sprintf("MUTATE_DEBUG=%t", opts.General.Debug),
+		"MUTATE_ORIGINAL=" + target,
+		"MUTATE_PACKAGE=" + pkg.Path(),
+		fmt.Sprintf("MUTATE_TIMEOUT=%d", opts.Exec.Timeout),
+		fmt.Sprintf("MUTATE_VERBOSE=%t", opts.General.Verbose),
+	}...)
+	if opts.Test.Recursive {
+		execCommand.Env = append(execCommand.Env, "TEST_RECURSIVE=true")
+	}
+	if run != "" {
+		execCommand.Env = append(execCommand.Env, "MUTATE_TESTS="+run)
+	}
+	if opts.Files.Tags != "" {
+		execCommand.Env = append(execCommand.Env, "MUTATE_TAGS="+opts.Files.Tags)
+	}
+	limitResources(opts, execCommand)
+
+	err = execCommand.Start()
+	if err != nil {
+		return result, fmt.Errorf("Could not execute %q: %v", opts.Exec.Exec, err)
+	}
+
+	err = execCommand.Wait()
+
+	if ctx.Err() == context.DeadlineExceeded {
+		c.Debug("Kill %q after the timeout", opts.Exec.Exec)
+
+		result.timeout = true
+	} else if err == nil {
+		result.execExitCode = 0
+	} else if e, ok := err.(*exec.ExitError); ok {
+		result.execExitCode = e.ExitCode()
+	} else {
+		return result, fmt.Errorf("Could not execute %q: %v", opts.Exec.Exec, err)
+	}
+
+	result.output = output.Bytes()
+
+	return result, nil
+}
+
+// builtinTest tests the mutation of the built-in exec command and returns the exit code and output of "go test".
+func builtinTest(
+	ctx context.Context,
+	opts *models.Options,
+	pkg *types.Package,
+	file string,
+	target string,
+	mutationFile string,
+	run string,
+	ws *workspace,
+	testBinaries *testbin.Runner,
+) (int, []byte, error) {
+	buildSystem := buildSystems[opts.Exec.BuildSystem]
+	if buildSystem != nil {
+		// Build systems do not support overlays, so the original file is replaced
+		defer func() {
+			_ = os.Rename(target+".tmp", target)
+		}()
+
+		err := os.Rename(target, target+".tmp")
+		if err != nil {
+			return 0, nil, fmt.Errorf("Could not move original file %q: %v", target, err)
+		}
+		err = osutil.CopyFile(mutationFile, target)
+		if err != nil {
+			return 0, nil, fmt.Errorf("Could not replace original file %q with mutation %q: %v", target, mutationFile, err)
+		}
+
+		testCmd, err := buildSystem.testCommand(opts, target)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		exitCode, output, err := runTest(opts, testCmd)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		return buildSystem.goTestExitCode(exitCode), output, nil
+	}
+
+	overlayFile, err := writeOverlay(target, mutationFile)
+	if err != nil {
+		return 0, nil, fmt.Errorf("Could not write overlay of mutation %q: %v", mutationFile, err)
+	}
+
+	if testBinaries != nil && !opts.Test.Recursive {
+		result, err := testBinaries.Test(filepath.Dir(target), overlayFile, mutationFile+".test", time.Duration(opts.Exec.Timeout)*time.Second, run)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		return result.ExitCode, result.Output, nil
+	}
+
+	pkgName, err := testedPackage(opts, pkg, file, ws)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	goTestArgs := []string{"test", "-overlay", overlayFile, "-timeout", fmt.Sprintf("%ds", opts.Exec.Timeout), "-tags", opts.Files.Tags}
+	if run != "" {
+		goTestArgs = append(goTestArgs, "-run", run)
+	}
+	goTestArgs = append(goTestArgs, strings.Fields(opts.Test.GoTestFlags)...)
+
+	testCmd := exec.CommandContext(ctx, goBinary(opts), append(goTestArgs, pkgName)...)
+	testCmd.Env = os.Environ()
+	if ws != nil {
+		testCmd.Dir = ws.root
+	}
+
+	return runTest(opts, testCmd)
+}
+
+// goBinary returns the Go command of the built-in exec command, which is the go command on the PATH if none is pinned.
+func goBinary(opts *models.Options) string {
+	if opts.Exec.GoBinary != "" {
+		return opts.Exec.GoBinary
+	} else if opts.Config.GoBinary != "" {
+		return opts.Config.GoBinary
+	}
+
+	return "go"
+}
+
+// goVersion returns the version of the given Go command, e.g. "go1.22.3".
+func goVersion(goBinary string) (string, error) {
+	out, err := exec.Command(goBinary, "env", "GOVERSION").Output()
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(out)), nil
+}
+
+// testedPackage returns the package pattern which is tested by the built-in exec command for the mutation of the given file.
+func testedPackage(opts *models.Options, pkg *types.Package, file string, ws *workspace) (string, error) {
+	pkgName := pkg.Path()
+	if ws != nil {
+		var err error
+		pkgName, err = ws.pkg(file)
+		if err != nil {
+			return "", err
+		}
+	}
+	if opts.Test.Recursive {
+		pkgName += "/..."
+	}
+
+	return pkgName, nil
+}
+
+// vetMutation executes "go vet" for the package of the mutation and reports whether go vet caught the mutation.
+// Mutations which do not compile are not caught since the failing build is reported by their tests.
+func vetMutation(ctx context.Context, opts *models.Options, pkg *types.Package, file string, target string, mutationFile string, ws *workspace) (bool, []byte, error) {
+	overlayFile, err := writeOverlay(target, mutationFile)
+	if err != nil {
+		return false, nil, fmt.Errorf("Could not write overlay of mutation %q: %v", mutationFile, err)
+	}
+
+	pkgName, err := testedPackage(opts, pkg, file, ws)
+	if err != nil {
+		return false, nil, err
+	}
+
+	goCmd := func(args ...string) (int, []byte, error) {
+		cmd := exec.CommandContext(ctx, goBinary(opts), append(append(args, "-overlay", overlayFile, "-tags", opts.Files.Tags), pkgName)...)
+		cmd.Env = os.Environ()
+		if ws != nil {
+			cmd.Dir = ws.root
+		}
+
+		return runTest(opts, cmd)
+	}
+
+	exitCode, output, err := goCmd("vet")
+	if err != nil || exitCode == 0 {
+		return false, output, err
+	}
+
+	// go vet fails for type errors as well, which are no findings of its analyzers
+	exitCode, _, err = goCmd("build", "-o", os.DevNull)
+	if err != nil || exitCode != 0 {
+		return false, output, err
+	}
+
+	return true, output, nil
+}
+
+// runTest executes the test command and returns its exit code and output, it returns an error if the command could not be executed.
+func runTest(opts *models.Options, testCmd *exec.Cmd) (int, []byte, error) {
+	limitResources(opts, testCmd)
+
+	output, err := testCmd.CombinedOutput()
+	if err == nil {
+		return 0, output, nil
+	} else if e, ok := err.(*exec.ExitError); ok {
+		return e.ExitCode(), output, nil
+	}
+
+	return 0, output, fmt.Errorf("Could not execute %q: %v", testCmd.String(), err)
+}
+
+// limitResources limits the CPU usage of the tests of a mutation according to the exec options.
+func limitResources(opts *models.Options, cmd *exec.Cmd) {
+	if opts.Exec.GoMaxProcs > 0 {
+		if cmd.Env == nil {
+			cmd.Env = os.Environ()
+		}
+		cmd.Env = append(cmd.Env, fmt.Sprintf("GOMAXPROCS=%d", opts.Exec.GoMaxProcs))
+	}
+	if opts.Exec.Nice > 0 {
+		setNice(cmd, opts.Exec.Nice)
+	}
+}
+
+// diffMutation returns the unified diff of the original file and the mutation file with the given labels.
+func diffMutation(originalFile string, mutationFile string, fromFile string, toFile string) ([]byte, error) {
+	original, err := os.ReadFile(originalFile)
+	if err != nil {
+		return nil, err
+	}
+
+	mutated, err := os.ReadFile(mutationFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return parser.UnifiedDiff(original, mutated, fromFile, toFile)
+}
+
+// writeOverlay writes a "go build -overlay" file next to the mutation which replaces the original file with the mutation.
+func writeOverlay(originalFile string, mutationFile string) (string, error) {
+	original, err := filepath.Abs(originalFile)
+	if err != nil {
+		return "", err
+	}
+	mutation, err := filepath.Abs(mutationFile)
+	if err != nil {
+		return "", err
+	}
+
+	content, err := json.Marshal(map[string]map[string]string{
+		"Replace": {
+			original: mutation,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	overlayFile := mutation + ".overlay.json"
+
+	return overlayFile, os.WriteFile(overlayFile, content, 0666)
+}
+
+// printAST returns the printed and the formatted source code of the node.
+func printAST(fset *token.FileSet, node ast.Node) ([]byte, []byte, error) {
+	var buf bytes.Buffer
+
+	err := printer.Fprint(&buf, fset, node)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return buf.Bytes(), src, nil
+}
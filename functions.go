@@ -0,0 +1,21 @@
+package mutesting
+
+import (
+	"go/ast"
+	"go/token"
+
+	"github.com/VirtualRoyalty/go-mutesting/astutil"
+)
+
+// functionName returns the name of the function which contains the given line, methods are prefixed with their receiver type, e.g. "T.m".
+// Package level code has no function name.
+func functionName(fset *token.FileSet, file *ast.File, line int) string {
+	f, recv := astutil.FuncDeclAt(fset, file, line)
+	if f == nil {
+		return ""
+	} else if recv != "" {
+		return recv + "." + f.Name.Name
+	}
+
+	return f.Name.Name
+}
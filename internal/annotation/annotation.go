@@ -15,6 +15,8 @@ const (
 	FuncAnnotation     = "// mutator-disable-func"
 	RegexpAnnotation   = "// mutator-disable-regexp"
 	NextLineAnnotation = "// mutator-disable-next-line"
+	BeginAnnotation    = "// mutator-disable-begin"
+	EndAnnotation      = "// mutator-disable-end"
 )
 
 // Processor handles mutation exclusion logic based on source code annotations.
@@ -64,6 +66,8 @@ func (p *Processor) Collect(file *ast.File, fset *token.FileSet, fileAbs string)
 		}
 	}
 
+	p.LineAnnotation.closeRange(file)
+
 	p.collectNodesForBlockStmt()
 }
 
@@ -71,7 +75,8 @@ func (p *Processor) Collect(file *ast.File, fset *token.FileSet, fileAbs string)
 func (p *Processor) ShouldSkip(node ast.Node, mutatorName string) bool {
 	return p.FunctionAnnotation.filterFunctions(node) ||
 		p.RegexAnnotation.filterRegexNodes(node, mutatorName) ||
-		p.LineAnnotation.filterNodesOnNextLine(node, mutatorName)
+		p.LineAnnotation.filterNodesOnNextLine(node, mutatorName) ||
+		p.LineAnnotation.filterNodesInRanges(node, mutatorName)
 }
 
 // DecoratorFilter creates a mutator that applies one or more filters before executing the provided mutator.
@@ -99,6 +104,12 @@ func getAnnotationName(comment *ast.Comment) string {
 	if strings.HasPrefix(content, FuncAnnotation) {
 		return FuncAnnotation
 	}
+	if strings.HasPrefix(content, BeginAnnotation) {
+		return BeginAnnotation
+	}
+	if strings.HasPrefix(content, EndAnnotation) {
+		return EndAnnotation
+	}
 
 	return ""
 }
@@ -138,6 +149,7 @@ func (p *Processor) collectNodesForBlockStmt() {
 	cleanupGlobalStatBlock()
 	p.RegexAnnotation.copyToStatNodesInBlock()
 	p.LineAnnotation.copyToStatNodesInBlock()
+	p.LineAnnotation.copyToStatRangesInBlock()
 }
 
 // parseMutators parses a comma-separated string of mutator names into a clean slice of strings.
@@ -467,3 +467,36 @@ func TestCollect(t *testing.T) {
 	})
 
 }
+
+func TestCollectRanges(t *testing.T) {
+	fs := token.NewFileSet()
+	file, err := parser.ParseFile(fs, "../../testdata/annotation/range.go", nil, parser.AllErrors|parser.ParseComments)
+	if err != nil {
+		t.Fatalf("failed to parse file: %v", err)
+	}
+
+	processor := NewProcessor()
+
+	processor.Collect(file, fs, "../../testdata/annotation/range.go")
+
+	if !assert.Len(t, processor.LineAnnotation.Ranges, 2) {
+		return
+	}
+	assert.Equal(t, []string{"numbers/incrementer", "statement/remove"}, processor.LineAnnotation.Ranges[0].Mutators.Names)
+	assert.Equal(t, []string{"*"}, processor.LineAnnotation.Ranges[1].Mutators.Names)
+	assert.Equal(t, file.FileEnd, processor.LineAnnotation.Ranges[1].End)
+
+	body := file.Decls[1].(*ast.FuncDecl).Body.List
+	inFirst := body[0].(*ast.AssignStmt).Rhs[0].(*ast.BinaryExpr).Y
+	afterFirst := body[2].(*ast.AssignStmt).Rhs[0].(*ast.BinaryExpr).Y
+	inSecond := body[3].(*ast.ReturnStmt).Results[0]
+
+	assert.True(t, processor.ShouldSkip(inFirst, "numbers/incrementer"))
+	assert.False(t, processor.ShouldSkip(inFirst, "numbers/decrementer"))
+	assert.False(t, processor.ShouldSkip(afterFirst, "numbers/incrementer"))
+	assert.True(t, processor.ShouldSkip(inSecond, "arithmetic/base"))
+
+	assert.True(t, HandleBlockStmt(body[1]))
+	assert.False(t, HandleBlockStmt(body[2]))
+	assert.False(t, processor.ShouldSkip(file.Decls[1], "numbers/incrementer"))
+}
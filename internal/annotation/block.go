@@ -7,6 +7,7 @@ import (
 
 var statNodesInBlockForRegex = make(map[int]map[token.Pos]mutatorInfo)
 var statNodesInBlockForLine = make(map[int]map[token.Pos]mutatorInfo)
+var statRangesInBlock []LineRange
 
 // HandleBlockStmt is a temporary workaround specifically for handling BlockStmt nodes in AST.
 // It performs cleanup and transfers collected annotation data to statement nodes within blocks.
@@ -29,12 +30,19 @@ func HandleBlockStmt(node ast.Stmt) bool {
 		}
 	}
 
+	for _, r := range statRangesInBlock {
+		if node.Pos() >= r.Start && node.End() <= r.End && shouldSkipMutator(r.Mutators, "statement/remove") {
+			return true
+		}
+	}
+
 	return false
 }
 
 func cleanupGlobalStatBlock() {
 	statNodesInBlockForRegex = make(map[int]map[token.Pos]mutatorInfo)
 	statNodesInBlockForLine = make(map[int]map[token.Pos]mutatorInfo)
+	statRangesInBlock = nil
 }
 
 func (r *RegexAnnotation) copyToStatNodesInBlock() {
@@ -49,6 +57,10 @@ func (r *RegexAnnotation) copyToStatNodesInBlock() {
 	}
 }
 
+func (l *LineAnnotation) copyToStatRangesInBlock() {
+	statRangesInBlock = append(statRangesInBlock, l.Ranges...)
+}
+
 func (l *LineAnnotation) copyToStatNodesInBlock() {
 	for line, nodes := range l.Exclusions {
 		if _, exists := statNodesInBlockForLine[line]; !exists {
@@ -62,10 +62,30 @@ func (n *NextLineAnnotationCollector) Handle(name string, comment *ast.Comment,
 	}
 }
 
+// RangeAnnotationCollector implements the ChainCollector interface for "mutator-disable-begin" and "mutator-disable-end" annotations.
+type RangeAnnotationCollector struct {
+	BaseCollector
+	Processor *LineAnnotation
+}
+
+// Handle processes begin and end annotations of regions, delegating other types to the next handler.
+func (r *RangeAnnotationCollector) Handle(name string, comment *ast.Comment, fset *token.FileSet, file *ast.File, fileAbs string) {
+	switch name {
+	case BeginAnnotation:
+		r.Processor.beginRange(comment)
+	case EndAnnotation:
+		r.Processor.endRange(comment)
+	default:
+		r.BaseCollector.Handle(name, comment, fset, file, fileAbs)
+	}
+}
+
 func (p *Processor) buildChain() ChainCollector {
 	regexHandler := &RegexAnnotationCollector{Processor: p.RegexAnnotation}
 	nextLineHandler := &NextLineAnnotationCollector{Processor: p.LineAnnotation}
+	rangeHandler := &RangeAnnotationCollector{Processor: &p.LineAnnotation}
 	regexHandler.SetNext(nextLineHandler)
+	nextLineHandler.SetNext(rangeHandler)
 
 	return regexHandler
 }
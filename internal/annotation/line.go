@@ -9,7 +9,19 @@ import (
 // LineAnnotation represents a collection of exclusions based on lines in the file.
 type LineAnnotation struct {
 	Exclusions map[int]map[token.Pos]mutatorInfo
-	Name       string
+	// Ranges are the regions between "mutator-disable-begin" and "mutator-disable-end" annotations.
+	Ranges []LineRange
+	Name   string
+
+	// open is the region of a begin annotation which has not been ended yet
+	open *LineRange
+}
+
+// LineRange represents a region of the file whose nodes are excluded from mutation.
+type LineRange struct {
+	Start    token.Pos
+	End      token.Pos
+	Mutators mutatorInfo
 }
 
 // parseLineAnnotation parses a comment line containing a next-line annotation.
@@ -60,3 +72,47 @@ func (l *LineAnnotation) filterNodesOnNextLine(node ast.Node, mutatorName string
 
 	return false
 }
+
+// beginRange processes a "mutator-disable-begin" annotation which starts a region until the next "mutator-disable-end" annotation.
+// A begin annotation inside of a region ends the region before a new one is started.
+func (l *LineAnnotation) beginRange(comment *ast.Comment) {
+	l.closeRangeAt(comment.Pos())
+
+	content := strings.TrimSpace(strings.TrimPrefix(comment.Text, BeginAnnotation))
+
+	l.open = &LineRange{
+		Start:    comment.End(),
+		Mutators: mutatorInfo{Names: parseMutators(content)},
+	}
+}
+
+// endRange processes a "mutator-disable-end" annotation, end annotations without a region are ignored.
+func (l *LineAnnotation) endRange(comment *ast.Comment) {
+	l.closeRangeAt(comment.Pos())
+}
+
+// closeRange ends a region which was not ended by an end annotation at the end of the file.
+func (l *LineAnnotation) closeRange(file *ast.File) {
+	l.closeRangeAt(file.FileEnd)
+}
+
+func (l *LineAnnotation) closeRangeAt(end token.Pos) {
+	if l.open == nil {
+		return
+	}
+
+	l.open.End = end
+	l.Ranges = append(l.Ranges, *l.open)
+	l.open = nil
+}
+
+// filterNodesInRanges checks if a given node lies completely inside of a region whose annotation excludes the current mutator.
+func (l *LineAnnotation) filterNodesInRanges(node ast.Node, mutatorName string) bool {
+	for _, r := range l.Ranges {
+		if node.Pos() >= r.Start && node.End() <= r.End && shouldSkipMutator(r.Mutators, mutatorName) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,56 @@
+package console
+
+import (
+	"fmt"
+
+	"github.com/VirtualRoyalty/go-mutesting/internal/models"
+)
+
+// Console renders the console output of a run with its own renderer instead of the current renderer,
+// so runs with different outputs do not interfere.
+type Console struct {
+	renderer Renderer
+	opts     *models.Options
+}
+
+// New returns a console which renders with the given renderer, the options decide if debug and verbose messages are rendered.
+func New(renderer Renderer, opts *models.Options) *Console {
+	return &Console{
+		renderer: renderer,
+		opts:     opts,
+	}
+}
+
+// Message renders an informational line.
+func (c *Console) Message(format string, args ...interface{}) {
+	c.renderer.Message(fmt.Sprintf(format, args...))
+}
+
+// Debug renders an informational line when debug mode is enabled.
+func (c *Console) Debug(format string, args ...interface{}) {
+	if c.opts.General.Debug {
+		c.Message(format, args...)
+	}
+}
+
+// Verbose renders an informational line when either verbose or debug mode is enabled.
+func (c *Console) Verbose(format string, args ...interface{}) {
+	if c.opts.General.Verbose || c.opts.General.Debug {
+		c.Message(format, args...)
+	}
+}
+
+// Output renders the output of an executed test command.
+func (c *Console) Output(output []byte) {
+	c.renderer.Output(output)
+}
+
+// Diff renders the diff of a mutation.
+func (c *Console) Diff(diff []byte) {
+	c.renderer.Diff(diff)
+}
+
+// Result renders the verdict of a mutation.
+func (c *Console) Result(status string, out string) {
+	c.renderer.Result(status, out)
+}
@@ -0,0 +1,33 @@
+package console
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/VirtualRoyalty/go-mutesting/internal/models"
+)
+
+func TestConsole(t *testing.T) {
+	var buf bytes.Buffer
+	opts := &models.Options{}
+	c := New(NewPlainRenderer(&buf), opts)
+
+	c.Message("message %d", 1)
+	c.Verbose("verbose")
+	c.Debug("debug")
+	assert.Equal(t, "message 1\n", buf.String())
+
+	buf.Reset()
+	opts.General.Verbose = true
+	c.Verbose("verbose")
+	c.Debug("debug")
+	assert.Equal(t, "verbose\n", buf.String())
+
+	buf.Reset()
+	opts.General.Debug = true
+	c.Verbose("verbose")
+	c.Debug("debug")
+	assert.Equal(t, "verbose\ndebug\n", buf.String())
+}
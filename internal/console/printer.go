@@ -3,18 +3,21 @@ package console
 import (
 	"fmt"
 	"github.com/VirtualRoyalty/go-mutesting/internal/models"
+	"io"
 	"log"
+	"sort"
 	"strings"
-
-	"github.com/fatih/color"
+	"text/tabwriter"
 )
 
-// for colouring
+// Statuses of executed mutations
 const (
 	PASS    = "PASS"
 	FAIL    = "FAIL"
 	SKIP    = "SKIP"
 	UNKNOWN = "UNKNOWN"
+	TIMEOUT = "TIMEOUT"
+	VET     = "VET"
 )
 
 var (
@@ -22,82 +25,85 @@ var (
 	frameLine = strings.Repeat("-", length)
 )
 
-// PrintPass prints in green
-func PrintPass(out string) {
-	pass := color.New(color.FgHiWhite, color.BgGreen).SprintfFunc()
-	out = strings.Replace(out, PASS, pass(PASS), 1)
-	fmt.Print(out)
-	color.Blue(frameLine)
-}
+// PrintFileSummary prints a table with the stats of every mutated file
+func PrintFileSummary(w io.Writer, report *models.Report) {
+	if len(report.Files) == 0 {
+		return
+	}
 
-// PrintFail prints in red
-func PrintFail(out string) {
-	fail := color.New(color.FgHiWhite, color.BgRed).SprintfFunc()
-	out = strings.Replace(out, FAIL, fail(FAIL), 1)
-	fmt.Print(out)
-	color.Blue(frameLine)
-}
+	files := make([]string, 0, len(report.Files))
+	for file := range report.Files {
+		files = append(files, file)
+	}
+	sort.Strings(files)
 
-// PrintSkip prints in yellow
-func PrintSkip(out string) {
-	skip := color.New(color.FgHiWhite, color.BgYellow).SprintfFunc()
-	out = strings.Replace(out, SKIP, skip(SKIP), 1)
-	fmt.Print(out)
-	color.Blue(frameLine)
-}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	_, _ = fmt.Fprintln(tw, "File\tKilled\tEscaped\tSkipped\tNot covered\tDuplicated\tTotal\tMSI")
+	for _, file := range files {
+		stats := report.Files[file]
+
+		_, _ = fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%d\t%d\t%d\t%.2f\n",
+			file,
+			stats.KilledCount,
+			stats.EscapedCount,
+			stats.SkippedCount,
+			stats.NotCoveredCount,
+			stats.DuplicatedCount,
+			stats.TotalMutantsCount,
+			stats.Msi,
+		)
+	}
 
-// PrintUnknown prints in magenta
-func PrintUnknown(out string) {
-	unknown := color.New(color.FgHiWhite, color.BgMagenta).SprintfFunc()
-	out = strings.Replace(out, UNKNOWN, unknown(UNKNOWN), 1)
-	fmt.Print(out)
-	color.Blue(frameLine)
+	err := tw.Flush()
+	if err != nil {
+		log.Printf("Error printing output: %s", err)
+	}
 }
 
-// PrintDiff prints colorful diff
-func PrintDiff(diff []byte) {
-	green := color.New(color.FgHiWhite).Add(color.BgGreen)
-	red := color.New(color.FgHiWhite).Add(color.BgRed)
-
-	lines := string(diff)
-	for _, line := range strings.Split(lines, "\n") {
-		switch {
-		case strings.HasPrefix(line, "+++"):
-			_, err := green.Println(line)
-			if err != nil {
-				log.Printf("Error printing output: %s", err)
-			}
-		case strings.HasPrefix(line, "---"):
-			_, err := red.Println(line)
-			if err != nil {
-				log.Printf("Error printing output: %s", err)
-			}
-		case strings.HasPrefix(line, "+"):
-			_, err := green.Println(line)
-			if err != nil {
-				log.Printf("Error printing output: %s", err)
-			}
-		case strings.HasPrefix(line, "-"):
-			_, err := red.Println(line)
-			if err != nil {
-				log.Printf("Error printing output: %s", err)
-			}
-		default:
-			fmt.Println(line)
-		}
+// WeakestFunctionsLimit is the count of the weakest functions which are printed
+const WeakestFunctionsLimit = 10
+
+// PrintFunctionSummary prints a table with the stats of the functions with the lowest mutation scores
+func PrintFunctionSummary(w io.Writer, report *models.Report, limit int) {
+	functions := report.WeakestFunctions(limit)
+	if len(functions) == 0 {
+		return
+	}
+
+	_, _ = fmt.Fprintln(w, "Weakest functions:")
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	_, _ = fmt.Fprintln(tw, "Function\tFile\tKilled\tEscaped\tNot covered\tTotal\tMSI")
+	for _, stats := range functions {
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%d\t%d\t%d\t%d\t%.2f\n",
+			stats.Function,
+			stats.File,
+			stats.KilledCount,
+			stats.EscapedCount,
+			stats.NotCoveredCount,
+			stats.TotalMutantsCount,
+			stats.Msi,
+		)
+	}
+
+	err := tw.Flush()
+	if err != nil {
+		log.Printf("Error printing output: %s", err)
 	}
 }
 
 // Debug prints formatted debug messages when debug mode is enabled in options.
 func Debug(opts *models.Options, format string, args ...interface{}) {
 	if opts.General.Debug {
-		fmt.Printf(format+"\n", args...)
+		Message(format, args...)
 	}
 }
 
 // Verbose prints formatted messages when either verbose or debug mode is enabled.
 func Verbose(opts *models.Options, format string, args ...interface{}) {
 	if opts.General.Verbose || opts.General.Debug {
-		fmt.Printf(format+"\n", args...)
+		Message(format, args...)
 	}
 }
@@ -0,0 +1,61 @@
+package console
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/VirtualRoyalty/go-mutesting/internal/models"
+)
+
+func TestPrintFileSummary(t *testing.T) {
+	report := &models.Report{}
+
+	report.File("b/b.go").KilledCount = 1
+	report.File("b/b.go").EscapedCount = 3
+	report.File("b/b.go").NotCoveredCount = 2
+	report.File("a.go").KilledCount = 3
+	report.File("a.go").SkippedCount = 1
+	report.File("a.go").DuplicatedCount = 2
+	report.Calculate()
+
+	var buf bytes.Buffer
+	PrintFileSummary(&buf, report)
+
+	assert.Equal(t, ""+
+		"File    Killed  Escaped  Skipped  Not covered  Duplicated  Total  MSI\n"+
+		"a.go    3       0        1        0            2           4      1.00\n"+
+		"b/b.go  1       3        0        2            0           6      0.17\n",
+		buf.String(),
+	)
+}
+
+func TestPrintFileSummaryEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	PrintFileSummary(&buf, &models.Report{})
+
+	assert.Empty(t, buf.String())
+}
+
+func TestPrintFunctionSummary(t *testing.T) {
+	report := &models.Report{}
+
+	report.Function("a.go", "f").KilledCount = 1
+	report.Function("a.go", "f").EscapedCount = 1
+	report.Function("b/b.go", "T.m").EscapedCount = 2
+	report.Function("b/b.go", "T.m").NotCoveredCount = 1
+	report.Function("b/b.go", "g").KilledCount = 2
+	report.Calculate()
+
+	var buf bytes.Buffer
+	PrintFunctionSummary(&buf, report, 10)
+
+	assert.Equal(t, ""+
+		"Weakest functions:\n"+
+		"Function  File    Killed  Escaped  Not covered  Total  MSI\n"+
+		"T.m       b/b.go  0       2        1            3      0.00\n"+
+		"f         a.go    1       1        0            2      0.50\n",
+		buf.String(),
+	)
+}
@@ -0,0 +1,336 @@
+package console
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/fatih/color"
+
+	"github.com/VirtualRoyalty/go-mutesting/internal/models"
+)
+
+// Names of the renderers of the console output
+const (
+	RendererColor    = "color"
+	RendererPlain    = "plain"
+	RendererJSON     = "json"
+	RendererProgress = "progress"
+)
+
+// Renderer renders the console output of a run.
+type Renderer interface {
+	// Message renders an informational line, e.g. of the debug and verbose output.
+	Message(msg string)
+	// Output renders the output of an executed test command.
+	Output(output []byte)
+	// Diff renders the diff of a mutation.
+	Diff(diff []byte)
+	// Result renders the verdict of a mutation, the status is one of PASS, VET, FAIL, SKIP, UNKNOWN and TIMEOUT.
+	Result(status string, out string)
+	// Summary renders the summary lines and the stats of every mutated file after all mutations were executed.
+	Summary(lines []string, report *models.Report)
+}
+
+var (
+	rendererMutex sync.Mutex
+	renderer      Renderer = NewColorRenderer(stdout{})
+)
+
+// NewRenderer returns the renderer with the given name which writes into w.
+func NewRenderer(name string, w io.Writer) (Renderer, error) {
+	switch name {
+	case RendererColor, "":
+		return NewColorRenderer(w), nil
+	case RendererPlain:
+		return NewPlainRenderer(w), nil
+	case RendererJSON:
+		return NewJSONRenderer(w), nil
+	case RendererProgress:
+		return NewProgressRenderer(w), nil
+	}
+
+	return nil, fmt.Errorf("unknown renderer %q", name)
+}
+
+// SetRenderer sets the renderer of the console output.
+func SetRenderer(r Renderer) {
+	rendererMutex.Lock()
+	defer rendererMutex.Unlock()
+
+	renderer = r
+}
+
+func current() Renderer {
+	rendererMutex.Lock()
+	defer rendererMutex.Unlock()
+
+	return renderer
+}
+
+// Message renders an informational line with the current renderer.
+func Message(format string, args ...interface{}) {
+	current().Message(fmt.Sprintf(format, args...))
+}
+
+// Output renders the output of an executed test command with the current renderer.
+func Output(output []byte) {
+	current().Output(output)
+}
+
+// Diff renders the diff of a mutation with the current renderer.
+func Diff(diff []byte) {
+	current().Diff(diff)
+}
+
+// Result renders the verdict of a mutation with the current renderer.
+func Result(status string, out string) {
+	current().Result(status, out)
+}
+
+// Summary renders the summary of the report with the current renderer.
+func Summary(lines []string, report *models.Report) {
+	current().Summary(lines, report)
+}
+
+// stdout writes into the current standard output, which can be replaced after the renderer was created.
+type stdout struct{}
+
+func (stdout) Write(p []byte) (int, error) {
+	return os.Stdout.Write(p)
+}
+
+// PlainRenderer renders the console output as text without colors.
+type PlainRenderer struct {
+	w io.Writer
+}
+
+// NewPlainRenderer returns a renderer of text without colors.
+func NewPlainRenderer(w io.Writer) *PlainRenderer {
+	return &PlainRenderer{
+		w: w,
+	}
+}
+
+// Message renders the message as its own line.
+func (r *PlainRenderer) Message(msg string) {
+	_, _ = fmt.Fprintln(r.w, msg)
+}
+
+// Output renders the output as it is.
+func (r *PlainRenderer) Output(output []byte) {
+	_, _ = r.w.Write(output)
+}
+
+// Diff renders the diff as it is.
+func (r *PlainRenderer) Diff(diff []byte) {
+	_, _ = fmt.Fprintln(r.w, string(diff))
+}
+
+// Result renders the verdict followed by a frame line.
+func (r *PlainRenderer) Result(_ string, out string) {
+	_, _ = fmt.Fprint(r.w, out)
+	_, _ = fmt.Fprintln(r.w, frameLine)
+}
+
+// Summary renders every summary line followed by the tables of the file stats and the weakest functions.
+func (r *PlainRenderer) Summary(lines []string, report *models.Report) {
+	for _, line := range lines {
+		_, _ = fmt.Fprintln(r.w, line)
+	}
+
+	PrintFileSummary(r.w, report)
+	PrintFunctionSummary(r.w, report, WeakestFunctionsLimit)
+}
+
+// ColorRenderer renders the console output as text with colored statuses and diffs.
+type ColorRenderer struct {
+	PlainRenderer
+}
+
+// NewColorRenderer returns a renderer of text with colors, colors are disabled if the output is not a terminal.
+func NewColorRenderer(w io.Writer) *ColorRenderer {
+	return &ColorRenderer{
+		PlainRenderer: PlainRenderer{
+			w: w,
+		},
+	}
+}
+
+var statusColors = map[string]color.Attribute{
+	PASS:    color.BgGreen,
+	FAIL:    color.BgRed,
+	SKIP:    color.BgYellow,
+	UNKNOWN: color.BgMagenta,
+	TIMEOUT: color.BgCyan,
+	VET:     color.BgBlue,
+}
+
+// Diff renders added lines in green and removed lines in red.
+func (r *ColorRenderer) Diff(diff []byte) {
+	green := color.New(color.FgHiWhite).Add(color.BgGreen)
+	red := color.New(color.FgHiWhite).Add(color.BgRed)
+
+	for _, line := range strings.Split(string(diff), "\n") {
+		var err error
+		switch {
+		case strings.HasPrefix(line, "+"):
+			_, err = green.Fprintln(r.w, line)
+		case strings.HasPrefix(line, "-"):
+			_, err = red.Fprintln(r.w, line)
+		default:
+			_, err = fmt.Fprintln(r.w, line)
+		}
+		if err != nil {
+			log.Printf("Error printing output: %s", err)
+		}
+	}
+}
+
+// Result renders the verdict with a colored status followed by a blue frame line.
+func (r *ColorRenderer) Result(status string, out string) {
+	if bg, ok := statusColors[status]; ok {
+		out = strings.Replace(out, status, color.New(color.FgHiWhite, bg).Sprint(status), 1)
+	}
+
+	_, _ = fmt.Fprint(r.w, out)
+	_, _ = color.New(color.FgBlue).Fprintln(r.w, frameLine)
+}
+
+// JSONRenderer renders every part of the console output as a JSON object on its own line.
+type JSONRenderer struct {
+	mutex   sync.Mutex
+	encoder *json.Encoder
+}
+
+// NewJSONRenderer returns a renderer of JSON lines.
+func NewJSONRenderer(w io.Writer) *JSONRenderer {
+	return &JSONRenderer{
+		encoder: json.NewEncoder(w),
+	}
+}
+
+// jsonEvent is one line of the JSON renderer.
+type jsonEvent struct {
+	Type    string                   `json:"type"`
+	Status  string                   `json:"status,omitempty"`
+	Message string                   `json:"message,omitempty"`
+	Lines   []string                 `json:"lines,omitempty"`
+	Stats   *models.Stats            `json:"stats,omitempty"`
+	Files   map[string]*models.Stats `json:"files,omitempty"`
+	// Functions are the weakest functions
+	Functions []*models.FunctionStats `json:"functions,omitempty"`
+}
+
+func (r *JSONRenderer) encode(event jsonEvent) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	err := r.encoder.Encode(event)
+	if err != nil {
+		log.Printf("Error printing output: %s", err)
+	}
+}
+
+// Message renders a "message" line.
+func (r *JSONRenderer) Message(msg string) {
+	r.encode(jsonEvent{Type: "message", Message: msg})
+}
+
+// Output renders an "output" line.
+func (r *JSONRenderer) Output(output []byte) {
+	r.encode(jsonEvent{Type: "output", Message: string(output)})
+}
+
+// Diff renders a "diff" line.
+func (r *JSONRenderer) Diff(diff []byte) {
+	r.encode(jsonEvent{Type: "diff", Message: string(diff)})
+}
+
+// Result renders a "result" line with the status.
+func (r *JSONRenderer) Result(status string, out string) {
+	r.encode(jsonEvent{Type: "result", Status: status, Message: strings.TrimSuffix(out, "\n")})
+}
+
+// Summary renders a "summary" line with the overall and per-file stats as well as the weakest functions.
+func (r *JSONRenderer) Summary(lines []string, report *models.Report) {
+	r.encode(jsonEvent{Type: "summary", Lines: lines, Stats: &report.Stats, Files: report.Files, Functions: report.WeakestFunctions(WeakestFunctionsLimit)})
+}
+
+// ProgressRenderer renders only a single line with the count of every status which is updated in place,
+// which is meant for terminals.
+type ProgressRenderer struct {
+	PlainRenderer
+
+	mutex  sync.Mutex
+	counts map[string]int
+	total  int
+}
+
+// NewProgressRenderer returns a renderer of a progress line.
+func NewProgressRenderer(w io.Writer) *ProgressRenderer {
+	return &ProgressRenderer{
+		PlainRenderer: PlainRenderer{
+			w: w,
+		},
+		counts: map[string]int{},
+	}
+}
+
+// clearLine is the escape sequence which moves to the start of the progress line and clears it.
+const clearLine = "\r\033[K"
+
+// Message renders the message above the progress line.
+func (r *ProgressRenderer) Message(msg string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	_, _ = fmt.Fprint(r.w, clearLine)
+	r.PlainRenderer.Message(msg)
+	r.progress()
+}
+
+// Output is not rendered.
+func (r *ProgressRenderer) Output([]byte) {}
+
+// Diff is not rendered.
+func (r *ProgressRenderer) Diff([]byte) {}
+
+// Result updates the progress line.
+func (r *ProgressRenderer) Result(status string, _ string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.counts[status]++
+	r.total++
+
+	_, _ = fmt.Fprint(r.w, clearLine)
+	r.progress()
+}
+
+func (r *ProgressRenderer) progress() {
+	if r.total == 0 {
+		return
+	}
+
+	_, _ = fmt.Fprintf(r.w, "%d mutations executed:", r.total)
+	for _, status := range []string{PASS, VET, FAIL, SKIP, TIMEOUT, UNKNOWN} {
+		_, _ = fmt.Fprintf(r.w, " %d %s", r.counts[status], status)
+	}
+}
+
+// Summary ends the progress line and renders the summary.
+func (r *ProgressRenderer) Summary(lines []string, report *models.Report) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.total > 0 {
+		_, _ = fmt.Fprintln(r.w)
+	}
+
+	r.PlainRenderer.Summary(lines, report)
+}
@@ -0,0 +1,96 @@
+package console
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/VirtualRoyalty/go-mutesting/internal/models"
+)
+
+func TestNewRenderer(t *testing.T) {
+	for name, expected := range map[string]Renderer{
+		"":               &ColorRenderer{},
+		RendererColor:    &ColorRenderer{},
+		RendererPlain:    &PlainRenderer{},
+		RendererJSON:     &JSONRenderer{},
+		RendererProgress: &ProgressRenderer{},
+	} {
+		r, err := NewRenderer(name, &bytes.Buffer{})
+		assert.NoError(t, err)
+		assert.IsType(t, expected, r, name)
+	}
+
+	_, err := NewRenderer("html", &bytes.Buffer{})
+	assert.EqualError(t, err, `unknown renderer "html"`)
+}
+
+func TestPlainRenderer(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewPlainRenderer(&buf)
+
+	r.Message("message")
+	r.Result(PASS, "PASS \"a.go.0\" with checksum 123\n")
+	r.Summary([]string{"The mutation score is 1.000000"}, &models.Report{})
+
+	assert.Equal(t, ""+
+		"message\n"+
+		"PASS \"a.go.0\" with checksum 123\n"+
+		frameLine+"\n"+
+		"The mutation score is 1.000000\n",
+		buf.String(),
+	)
+}
+
+func TestJSONRenderer(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewJSONRenderer(&buf)
+
+	report := &models.Report{}
+	report.Stats.KilledCount = 1
+	report.File("a.go").KilledCount = 1
+	report.Calculate()
+
+	r.Message("message")
+	r.Diff([]byte("-a\n+b"))
+	r.Result(FAIL, "FAIL \"a.go.0\" with checksum 123\n")
+	r.Summary([]string{"The mutation score is 1.000000"}, report)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Len(t, lines, 4)
+
+	var events []jsonEvent
+	for _, line := range lines {
+		var event jsonEvent
+		assert.NoError(t, json.Unmarshal([]byte(line), &event))
+		events = append(events, event)
+	}
+
+	assert.Equal(t, jsonEvent{Type: "message", Message: "message"}, events[0])
+	assert.Equal(t, jsonEvent{Type: "diff", Message: "-a\n+b"}, events[1])
+	assert.Equal(t, jsonEvent{Type: "result", Status: FAIL, Message: "FAIL \"a.go.0\" with checksum 123"}, events[2])
+	assert.Equal(t, "summary", events[3].Type)
+	assert.Equal(t, []string{"The mutation score is 1.000000"}, events[3].Lines)
+	assert.Equal(t, int64(1), events[3].Stats.KilledCount)
+	assert.Equal(t, int64(1), events[3].Files["a.go"].KilledCount)
+}
+
+func TestProgressRenderer(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewProgressRenderer(&buf)
+
+	r.Diff([]byte("-a\n+b"))
+	r.Result(PASS, "PASS \"a.go.0\" with checksum 123\n")
+	r.Result(FAIL, "FAIL \"a.go.1\" with checksum 456\n")
+	r.Summary([]string{"The mutation score is 0.500000"}, &models.Report{})
+
+	assert.Equal(t, ""+
+		clearLine+"1 mutations executed: 1 PASS 0 VET 0 FAIL 0 SKIP 0 TIMEOUT 0 UNKNOWN"+
+		clearLine+"2 mutations executed: 1 PASS 0 VET 1 FAIL 0 SKIP 0 TIMEOUT 0 UNKNOWN\n"+
+		"The mutation score is 0.500000\n",
+		buf.String(),
+	)
+}
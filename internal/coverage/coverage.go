@@ -0,0 +1,63 @@
+package coverage
+
+import (
+	"path/filepath"
+
+	"golang.org/x/tools/cover"
+)
+
+// Profile holds the coverage blocks of a coverage profile by their file name
+type Profile struct {
+	blocks map[string][]cover.ProfileBlock
+}
+
+// ParseProfile parses the coverage profile which is written by "go test -coverprofile"
+func ParseProfile(fileName string) (*Profile, error) {
+	profiles, err := cover.ParseProfiles(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Profile{
+		blocks: map[string][]cover.ProfileBlock{},
+	}
+
+	for _, profile := range profiles {
+		p.blocks[profile.FileName] = append(p.blocks[profile.FileName], profile.Blocks...)
+	}
+
+	return p, nil
+}
+
+// Covered checks if the given lines of the file are executed by at least one test.
+// The file is looked up by the import path of its package and by its absolute path.
+// Lines of files which are not part of the profile and lines without statements are treated as covered since their coverage is unknown.
+func (p *Profile) Covered(pkgPath string, file string, startLine int64, endLine int64) bool {
+	blocks, ok := p.blocks[pkgPath+"/"+filepath.Base(file)]
+	if !ok {
+		abs, err := filepath.Abs(file)
+		if err != nil {
+			return true
+		}
+
+		blocks, ok = p.blocks[filepath.ToSlash(abs)]
+		if !ok {
+			return true
+		}
+	}
+
+	found := false
+	for _, block := range blocks {
+		if int64(block.StartLine) > endLine || int64(block.EndLine) < startLine {
+			continue
+		}
+
+		if block.Count > 0 {
+			return true
+		}
+
+		found = true
+	}
+
+	return !found
+}
@@ -0,0 +1,42 @@
+package coverage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProfileCovered(t *testing.T) {
+	profile, err := ParseProfile("testdata/cover.out")
+	assert.Nil(t, err)
+
+	pkg := "github.com/VirtualRoyalty/go-mutesting/example"
+
+	tests := []struct {
+		name      string
+		pkg       string
+		file      string
+		startLine int64
+		endLine   int64
+		expected  bool
+	}{
+		{"covered block", pkg, "example/example.go", 11, 11, true},
+		{"uncovered block", pkg, "example/example.go", 15, 15, false},
+		{"uncovered blocks", pkg, "example/example.go", 15, 17, false},
+		{"partly covered blocks", pkg, "example/example.go", 17, 19, true},
+		{"line without statements", pkg, "example/example.go", 3, 3, true},
+		{"file not in profile", pkg, "example/other.go", 15, 15, true},
+		{"package not in profile", "github.com/VirtualRoyalty/go-mutesting/other", "example.go", 15, 15, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, profile.Covered(tt.pkg, tt.file, tt.startLine, tt.endLine))
+		})
+	}
+}
+
+func TestParseProfileMissingFile(t *testing.T) {
+	_, err := ParseProfile("testdata/missing.out")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,74 @@
+package filter
+
+import (
+	"go/ast"
+	"go/token"
+
+	"github.com/VirtualRoyalty/go-mutesting/astutil"
+	"github.com/VirtualRoyalty/go-mutesting/internal/gitdiff"
+)
+
+// ChangedLinesFilter is a filter that skips all nodes which do not overlap with the changed lines of their file.
+type ChangedLinesFilter struct {
+	changes *gitdiff.Changes
+	// functions extends the changed lines to the functions which contain them, changed lines outside of functions are ignored
+	functions bool
+
+	fset  *token.FileSet
+	lines []gitdiff.LineRange
+}
+
+// NewChangedLinesFilter creates and returns a new filter for the given changes.
+func NewChangedLinesFilter(changes *gitdiff.Changes) *ChangedLinesFilter {
+	return &ChangedLinesFilter{changes: changes}
+}
+
+// NewChangedFunctionsFilter creates and returns a new filter for the given changes which skips all nodes outside of the functions with changed lines.
+func NewChangedFunctionsFilter(changes *gitdiff.Changes) *ChangedLinesFilter {
+	return &ChangedLinesFilter{
+		changes:   changes,
+		functions: true,
+	}
+}
+
+// Collect collects the changed lines of the file
+func (c *ChangedLinesFilter) Collect(file *ast.File, fset *token.FileSet, fileAbs string) {
+	c.fset = fset
+	c.lines = c.changes.Lines(fileAbs)
+
+	if c.functions {
+		var functions []gitdiff.LineRange
+		for _, f := range astutil.Functions(file) {
+			if c.overlaps(f) {
+				functions = append(functions, gitdiff.LineRange{
+					Start: fset.Position(f.Pos()).Line,
+					End:   fset.Position(f.End()).Line,
+				})
+			}
+		}
+		c.lines = functions
+	}
+}
+
+// ShouldSkip determines whether a given AST node should be skipped during mutation.
+func (c *ChangedLinesFilter) ShouldSkip(node ast.Node, _ string) bool {
+	if c.fset == nil {
+		return false
+	}
+
+	return !c.overlaps(node)
+}
+
+// overlaps checks if the node overlaps with the collected lines.
+func (c *ChangedLinesFilter) overlaps(node ast.Node) bool {
+	start := c.fset.Position(node.Pos()).Line
+	end := c.fset.Position(node.End()).Line
+
+	for _, lines := range c.lines {
+		if lines.Start <= end && lines.End >= start {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,79 @@
+package filter
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/VirtualRoyalty/go-mutesting/internal/gitdiff"
+)
+
+func TestChangedLinesFilter(t *testing.T) {
+	code := `package main
+
+func foo() int {
+	n := 1
+	n++
+
+	return n
+}
+`
+
+	changes, err := gitdiff.Parse("/repo", strings.NewReader("+++ b/main.go\n@@ -5 +5 @@\n"))
+	assert.Nil(t, err)
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "/repo/main.go", code, 0)
+	assert.Nil(t, err)
+
+	f := NewChangedLinesFilter(changes)
+	f.Collect(file, fset, "/repo/main.go")
+
+	body := file.Decls[0].(*ast.FuncDecl).Body
+
+	assert.False(t, f.ShouldSkip(body, "statement/remove"))
+	assert.True(t, f.ShouldSkip(body.List[0], "statement/remove"))
+	assert.False(t, f.ShouldSkip(body.List[1], "statement/remove"))
+	assert.True(t, f.ShouldSkip(body.List[2], "statement/remove"))
+}
+
+func TestChangedFunctionsFilter(t *testing.T) {
+	code := `package main
+
+var x = 1
+
+func foo() int {
+	n := 1
+	n++
+
+	return n
+}
+
+func bar() int {
+	return 2
+}
+`
+
+	changes, err := gitdiff.Parse("/repo", strings.NewReader("+++ b/main.go\n@@ -3 +3 @@\n@@ -7 +7 @@\n"))
+	assert.Nil(t, err)
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "/repo/main.go", code, 0)
+	assert.Nil(t, err)
+
+	f := NewChangedFunctionsFilter(changes)
+	f.Collect(file, fset, "/repo/main.go")
+
+	foo := file.Decls[1].(*ast.FuncDecl).Body
+	bar := file.Decls[2].(*ast.FuncDecl).Body
+
+	assert.True(t, f.ShouldSkip(file.Decls[0], "numbers/incrementer"))
+	assert.False(t, f.ShouldSkip(foo.List[0], "statement/remove"))
+	assert.False(t, f.ShouldSkip(foo.List[1], "statement/remove"))
+	assert.False(t, f.ShouldSkip(foo.List[2], "statement/remove"))
+	assert.True(t, f.ShouldSkip(bar.List[0], "statement/remove"))
+}
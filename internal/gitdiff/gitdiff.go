@@ -0,0 +1,207 @@
+package gitdiff
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var hunkRegex = regexp.MustCompile(`^@@ -\d+(?:,\d+)? \+(\d+)(?:,(\d+))? @@`)
+
+// LineRange defines an inclusive range of lines.
+type LineRange struct {
+	Start int
+	End   int
+}
+
+// Changes holds the changed lines of files compared to a git ref.
+type Changes struct {
+	files map[string][]LineRange
+}
+
+// Diff returns the changes of the working tree of the git repository in the current directory compared to the given ref.
+// Untracked files are changed entirely.
+func Diff(ref string) (*Changes, error) {
+	root, changes, err := gitDiff(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	untracked, err := git("ls-files", "-z", "--others", "--exclude-standard", "--full-name", "--", ":/")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, file := range strings.Split(string(untracked), "\x00") {
+		if file == "" {
+			continue
+		}
+
+		changes.add(filepath.Join(root, filepath.FromSlash(file)), LineRange{
+			Start: 1,
+			End:   math.MaxInt,
+		})
+	}
+
+	return changes, nil
+}
+
+// Staged returns the changes of the git repository in the current directory which are staged for the next commit.
+func Staged() (*Changes, error) {
+	_, changes, err := gitDiff("--cached")
+
+	return changes, err
+}
+
+func gitDiff(args ...string) (string, *Changes, error) {
+	out, err := git("rev-parse", "--show-toplevel")
+	if err != nil {
+		return "", nil, err
+	}
+	root := strings.TrimSpace(string(out))
+
+	diff, err := git(append(append([]string{"-c", "core.quotePath=false", "diff", "--no-color", "--no-ext-diff", "--unified=0", "--src-prefix=a/", "--dst-prefix=b/"}, args...), "--")...)
+	if err != nil {
+		return "", nil, err
+	}
+
+	changes, err := Parse(root, bytes.NewReader(diff))
+	if err != nil {
+		return "", nil, err
+	}
+
+	return root, changes, nil
+}
+
+// Parse parses a unified diff with paths relative to the given root directory.
+// Only added and modified lines of the new files are taken into account.
+func Parse(root string, diff io.Reader) (*Changes, error) {
+	changes := &Changes{
+		files: map[string][]LineRange{},
+	}
+
+	file := ""
+
+	scanner := bufio.NewScanner(diff)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		switch {
+		case strings.HasPrefix(line, "+++ "):
+			name := strings.TrimPrefix(line, "+++ ")
+			if name == "/dev/null" {
+				file = ""
+			} else {
+				file = filepath.Join(root, filepath.FromSlash(strings.TrimPrefix(name, "b/")))
+			}
+		case strings.HasPrefix(line, "@@ ") && file != "":
+			m := hunkRegex.FindStringSubmatch(line)
+			if m == nil {
+				return nil, fmt.Errorf("invalid hunk header %q", line)
+			}
+
+			start, _ := strconv.Atoi(m[1])
+			count := 1
+			if m[2] != "" {
+				count, _ = strconv.Atoi(m[2])
+			}
+
+			// Hunks which only remove lines do not change any line of the new file
+			if count == 0 {
+				continue
+			}
+
+			changes.add(file, LineRange{
+				Start: start,
+				End:   start + count - 1,
+			})
+		}
+	}
+
+	return changes, scanner.Err()
+}
+
+func (c *Changes) add(file string, lines LineRange) {
+	c.files[file] = append(c.files[file], lines)
+}
+
+// Changed checks if the given file has changed lines.
+func (c *Changes) Changed(file string) bool {
+	return len(c.Lines(file)) != 0
+}
+
+// ChangedLine checks if the given line of the given file is changed.
+func (c *Changes) ChangedLine(file string, line int) bool {
+	for _, lines := range c.Lines(file) {
+		if lines.Start <= line && lines.End >= line {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Lines returns the changed lines of the given file.
+func (c *Changes) Lines(file string) []LineRange {
+	abs, err := filepath.Abs(file)
+	if err != nil {
+		return nil
+	}
+
+	if lines, ok := c.files[abs]; ok {
+		return lines
+	}
+
+	// The root of the repository has all symbolic links resolved
+	resolved, err := filepath.EvalSymlinks(abs)
+	if err != nil {
+		return nil
+	}
+
+	return c.files[resolved]
+}
+
+// Files returns only the changed files of the given files.
+func (c *Changes) Files(files []string) []string {
+	var changed []string
+
+	for _, file := range files {
+		if c.Changed(file) {
+			changed = append(changed, file)
+		}
+	}
+
+	return changed
+}
+
+// Head returns the SHA of the checked out commit of the git repository in the current directory.
+func Head() (string, error) {
+	out, err := git("rev-parse", "HEAD")
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(out)), nil
+}
+
+func git(args ...string) ([]byte, error) {
+	var stderr bytes.Buffer
+
+	cmd := exec.Command("git", args...)
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git %s: %v: %s", strings.Join(args, " "), err, strings.TrimSpace(stderr.String()))
+	}
+
+	return out, nil
+}
@@ -0,0 +1,54 @@
+package gitdiff
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const diff = `diff --git a/example/example.go b/example/example.go
+index 1b2c3d4..5e6f7a8 100644
+--- a/example/example.go
++++ b/example/example.go
+@@ -3 +3 @@ package example
+-import "fmt"
++import "os"
+@@ -10,0 +11,3 @@ func foo() int {
++	n := 1
++	n++
++	return n
+@@ -20,2 +23,0 @@ func bar() int {
+-	bar()
+-	bar()
+diff --git a/example/removed.go b/example/removed.go
+deleted file mode 100644
+index 1b2c3d4..0000000
+--- a/example/removed.go
++++ /dev/null
+@@ -1,3 +0,0 @@
+-package example
+-
+-var x = 1
+`
+
+func TestParse(t *testing.T) {
+	changes, err := Parse("/repo", strings.NewReader(diff))
+	assert.Nil(t, err)
+
+	assert.Equal(t, []LineRange{{Start: 3, End: 3}, {Start: 11, End: 13}}, changes.Lines("/repo/example/example.go"))
+	assert.True(t, changes.Changed("/repo/example/example.go"))
+	assert.False(t, changes.Changed("/repo/example/removed.go"))
+	assert.False(t, changes.Changed("/repo/example/other.go"))
+
+	assert.True(t, changes.ChangedLine("/repo/example/example.go", 12))
+	assert.False(t, changes.ChangedLine("/repo/example/example.go", 14))
+	assert.False(t, changes.ChangedLine("/repo/example/other.go", 3))
+
+	assert.Equal(t, []string{"/repo/example/example.go"}, changes.Files([]string{"/repo/example/example.go", "/repo/example/other.go"}))
+}
+
+func TestParseInvalidHunk(t *testing.T) {
+	_, err := Parse("/repo", strings.NewReader("+++ b/example.go\n@@ invalid @@\n"))
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,154 @@
+package impact
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/VirtualRoyalty/go-mutesting/internal/coverage"
+)
+
+var testNameRegex = regexp.MustCompile(`^(Test|Example|Fuzz)\w*$`)
+
+var failedTestRegex = regexp.MustCompile(`(?m)^\s*--- FAIL: ((?:Test|Example|Fuzz)\w*)`)
+
+// Selector selects the tests of a package which execute the lines of a mutation.
+// The tests and their coverage are gathered once per package by executing every test on its own with a coverage profile.
+type Selector struct {
+	dir      string
+	goBinary string
+	tags     string
+	packages map[string]*packageTests
+}
+
+type packageTests struct {
+	names    []string
+	profiles map[string]*coverage.Profile
+}
+
+// NewSelector creates a selector which saves its coverage profiles in the given directory and executes the tests with the given Go command and comma separated build tags.
+// The Go command on the PATH is used if the given Go command is empty.
+func NewSelector(dir string, goBinary string, tags string) *Selector {
+	if goBinary == "" {
+		goBinary = "go"
+	}
+
+	return &Selector{
+		dir:      dir,
+		goBinary: goBinary,
+		tags:     tags,
+		packages: map[string]*packageTests{},
+	}
+}
+
+// Tests returns the names of the tests of the package which execute at least one of the given lines of the file.
+// All tests are returned for lines of unknown coverage, e.g. lines without statements.
+func (s *Selector) Tests(pkgPath string, file string, startLine int64, endLine int64) ([]string, error) {
+	tests, err := s.packageTests(pkgPath, filepath.Dir(file))
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, name := range tests.names {
+		if tests.profiles[name].Covered(pkgPath, file, startLine, endLine) {
+			names = append(names, name)
+		}
+	}
+
+	return names, nil
+}
+
+func (s *Selector) packageTests(pkgPath string, dir string) (*packageTests, error) {
+	if tests, ok := s.packages[pkgPath]; ok {
+		return tests, nil
+	}
+
+	names, err := listTests(s.goBinary, dir, s.tags)
+	if err != nil {
+		return nil, err
+	}
+
+	tests := &packageTests{
+		names:    names,
+		profiles: map[string]*coverage.Profile{},
+	}
+
+	for _, name := range names {
+		profileFile := filepath.Join(s.dir, fmt.Sprintf("%d-%s.out", len(s.packages), name))
+
+		cmd := exec.Command(s.goBinary, "test", "-count=1", "-covermode=set", "-coverprofile="+profileFile, "-tags", s.tags, "-run", RunPattern([]string{name}), ".")
+		cmd.Dir = dir
+
+		// A failing test still writes its coverage profile
+		_, _ = cmd.CombinedOutput()
+
+		profile, err := coverage.ParseProfile(profileFile)
+		if err != nil {
+			return nil, fmt.Errorf("could not gather coverage of test %q in %q: %v", name, dir, err)
+		}
+
+		tests.profiles[name] = profile
+	}
+
+	s.packages[pkgPath] = tests
+
+	return tests, nil
+}
+
+// listTests returns the names of all tests, examples and fuzz tests of the package in the given directory with the given Go command and build tags.
+func listTests(goBinary string, dir string, tags string) ([]string, error) {
+	cmd := exec.Command(goBinary, "test", "-list", ".", "-tags", tags, ".")
+	cmd.Dir = dir
+	cmd.Env = os.Environ()
+
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("could not list tests in %q: %v", dir, err)
+	}
+
+	var names []string
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if testNameRegex.MatchString(line) {
+			names = append(names, line)
+		}
+	}
+	sort.Strings(names)
+
+	return names, scanner.Err()
+}
+
+// RunPattern returns the pattern for "go test -run" which matches exactly the given tests.
+func RunPattern(names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = regexp.QuoteMeta(name)
+	}
+
+	return "^(" + strings.Join(quoted, "|") + ")$"
+}
+
+// FailedTests returns the names of the failed top-level tests in the output of "go test", subtests are attributed to their tests.
+func FailedTests(output []byte) []string {
+	var names []string
+	seen := map[string]bool{}
+
+	for _, match := range failedTestRegex.FindAllSubmatch(output, -1) {
+		name := string(match[1])
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	return names
+}
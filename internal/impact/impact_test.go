@@ -0,0 +1,54 @@
+package impact
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectorTests(t *testing.T) {
+	selector := NewSelector(t.TempDir(), "", "")
+
+	pkg := "github.com/VirtualRoyalty/go-mutesting/internal/impact/testdata/calc"
+	file := "testdata/calc/calc.go"
+
+	tests, err := selector.Tests(pkg, file, 5, 5)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"TestAdd", "TestAddSub"}, tests)
+
+	tests, err = selector.Tests(pkg, file, 10, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"TestAddSub", "TestSub"}, tests)
+
+	tests, err = selector.Tests(pkg, file, 15, 15)
+	assert.Nil(t, err)
+	assert.Empty(t, tests)
+
+	tests, err = selector.Tests(pkg, file, 5, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"TestAdd", "TestAddSub", "TestSub"}, tests)
+
+	// Comments have no statements and therefore an unknown coverage
+	tests, err = selector.Tests(pkg, file, 3, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"TestAdd", "TestAddSub", "TestSub"}, tests)
+}
+
+func TestRunPattern(t *testing.T) {
+	assert.Equal(t, "^(TestFoo)$", RunPattern([]string{"TestFoo"}))
+	assert.Equal(t, "^(TestFoo|ExampleBar_baz)$", RunPattern([]string{"TestFoo", "ExampleBar_baz"}))
+}
+
+func TestFailedTests(t *testing.T) {
+	output := []byte(`--- FAIL: TestAdd (0.00s)
+    calc_test.go:7: wrong sum
+--- FAIL: TestSub (0.00s)
+    --- FAIL: TestSub/negative (0.00s)
+--- FAIL: ExampleMul (0.00s)
+FAIL
+FAIL	github.com/VirtualRoyalty/go-mutesting/internal/impact/testdata/calc	0.002s
+`)
+
+	assert.Equal(t, []string{"ExampleMul", "TestAdd", "TestSub"}, FailedTests(output))
+	assert.Empty(t, FailedTests([]byte("ok  	github.com/VirtualRoyalty/go-mutesting/example	0.002s\n")))
+}
@@ -0,0 +1,21 @@
+package calc
+
+import "testing"
+
+func TestAdd(t *testing.T) {
+	if Add(1, 2) != 3 {
+		t.Fail()
+	}
+}
+
+func TestSub(t *testing.T) {
+	if Sub(2, 1) != 1 {
+		t.Fail()
+	}
+}
+
+func TestAddSub(t *testing.T) {
+	if Sub(Add(1, 2), 2) != 1 {
+		t.Fail()
+	}
+}
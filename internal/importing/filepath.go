@@ -11,7 +11,10 @@ so TODO and FIXME. Heck I also give you a WORKAROUND.
 
 import (
 	"fmt"
+	"go/ast"
 	"go/build"
+	"go/parser"
+	"go/token"
 	"log"
 	"os"
 	"path"
@@ -20,6 +23,8 @@ import (
 	"sort"
 	"strings"
 
+	"golang.org/x/tools/go/packages"
+
 	"github.com/VirtualRoyalty/go-mutesting/internal/models"
 )
 
@@ -27,25 +32,21 @@ func packagesWithFilesOfArgs(args []string, opts *models.Options) map[string]map
 	var filenames []string
 
 	if len(args) == 0 {
-		filenames = append(filenames, checkDir(".")...)
-	} else {
-		for _, arg := range args {
-			if strings.HasSuffix(arg, "/...") && isDir(arg[:len(arg)-4]) {
-				for _, dirname := range allPackagesInFS(arg) {
-					filenames = append(filenames, checkDir(dirname)...)
-				}
-			} else if isDir(arg) {
-				filenames = append(filenames, checkDir(arg)...)
-			} else if exists(arg) {
-				filenames = append(filenames, arg)
-			} else {
-				for _, pkgname := range importPaths([]string{arg}) {
-					filenames = append(filenames, checkPackage(pkgname)...)
-				}
-			}
+		args = []string{"."}
+	}
+	for _, arg := range args {
+		if !isDir(arg) && exists(arg) {
+			filenames = append(filenames, arg)
+		} else if !build.IsLocalImport(arg) && !filepath.IsAbs(arg) && (isDir(arg) || strings.HasSuffix(arg, "/...") && isDir(arg[:len(arg)-4])) {
+			// Directories are preferred to import paths just like for files
+			filenames = append(filenames, filesOfPattern("./"+arg, opts.Files.Tags)...)
+		} else {
+			filenames = append(filenames, filesOfPattern(arg, opts.Files.Tags)...)
 		}
 	}
 
+	excludes := append(append([]string{}, opts.Files.Exclude...), opts.Config.Exclude...)
+
 	fileLookup := make(map[string]struct{})
 	pkgs := make(map[string]map[string]struct{})
 	var re *regexp.Regexp
@@ -72,10 +73,18 @@ func packagesWithFilesOfArgs(args []string, opts *models.Options) map[string]map
 			}
 		}
 
+		if excluded(excludes, filename) { // ignore files matching exclusion globs
+			continue
+		}
+
 		if strings.HasSuffix(filename, "_test.go") { // ignore test files
 			continue
 		}
 
+		if !opts.Files.IncludeGenerated && isGenerated(filename) { // ignore generated files
+			continue
+		}
+
 		if opts.Config.SkipFileWithoutTest || opts.Config.SkipFileWithBuildTag { // ignore files without tests
 			nameSize := len(filename)
 			if nameSize <= 3 {
@@ -117,6 +126,16 @@ func packagesWithFilesOfArgs(args []string, opts *models.Options) map[string]map
 	return pkgs
 }
 
+// isGenerated reports whether the file has a "// Code generated ... DO NOT EDIT." comment.
+func isGenerated(file string) bool {
+	src, err := parser.ParseFile(token.NewFileSet(), file, nil, parser.PackageClauseOnly|parser.ParseComments)
+	if err != nil {
+		return false
+	}
+
+	return ast.IsGenerated(src)
+}
+
 func regexpSearchInFile(file string, re *regexp.Regexp) bool {
 	contents, err := os.ReadFile(file)
 	if err != nil {
@@ -207,45 +226,110 @@ func exists(filename string) bool {
 	return err == nil
 }
 
-func checkDir(dirname string) []string {
-	pkg, err := build.ImportDir(dirname, 0)
+// filesOfPattern returns the Go files of the packages of a package pattern, e.g. a directory, an import path or a pattern with "...", which are resolved by the go tool.
+// Files of directory patterns are returned relative to the directory just like the pattern.
+func filesOfPattern(pattern string, tags string) []string {
+	pkgs, err := packages.Load(&packages.Config{
+		Mode:       packages.NeedName | packages.NeedFiles,
+		BuildFlags: []string{"-tags", tags},
+	}, pattern)
+	if err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, err)
 
-	return checkImportedPackage(pkg, err)
-}
+		return nil
+	}
+
+	dir := ""
+	if build.IsLocalImport(pattern) || filepath.IsAbs(pattern) {
+		dir = strings.TrimSuffix(pattern, "...")
+		if dir != pattern {
+			dir = filepath.Dir(dir + "x")
+		}
+	}
+
+	var files []string
+	for _, pkg := range pkgs {
+		for _, e := range pkg.Errors {
+			_, _ = fmt.Fprintln(os.Stderr, e)
+		}
+
+		for _, file := range pkg.GoFiles {
+			if dir != "" {
+				file = relativeTo(dir, file)
+			}
 
-func checkPackage(pkgname string) []string {
-	pkg, err := build.Import(pkgname, ".", 0)
+			files = append(files, file)
+		}
+	}
 
-	return checkImportedPackage(pkg, err)
+	return files
 }
 
-func checkImportedPackage(pkg *build.Package, err error) []string {
+// relativeTo returns the absolute file joined to the given directory.
+func relativeTo(dir string, file string) string {
+	abs, err := filepath.Abs(dir)
 	if err != nil {
-		if _, nogo := err.(*build.NoGoError); nogo {
-			// Don't complain if the failure is due to no Go source files.
-			return []string{}
-		}
-		_, err := fmt.Fprintln(os.Stderr, err)
-		if err != nil {
-			fmt.Println(err)
-		}
+		return file
+	}
 
-		return []string{}
+	rel, err := filepath.Rel(abs, file)
+	if err != nil {
+		return file
 	}
 
-	var files []string
+	return filepath.Join(dir, rel)
+}
 
-	files = append(files, pkg.GoFiles...)
+// CheckGlob returns an error if the pattern is not a valid exclusion glob.
+func CheckGlob(pattern string) error {
+	for _, segment := range strings.Split(pattern, "/") {
+		if _, err := path.Match(segment, ""); err != nil {
+			return fmt.Errorf("invalid glob %q: %v", pattern, err)
+		}
+	}
 
-	joinDirWithFilenames(pkg.Dir, files)
+	return nil
+}
 
-	return files
+// matchGlob reports whether the file matches the glob pattern which is matched segment by segment with path.Match,
+// besides that a "**" segment matches any count of directories.
+func matchGlob(pattern string, file string) bool {
+	return matchSegments(strings.Split(pattern, "/"), strings.Split(filepath.ToSlash(filepath.Clean(file)), "/"))
 }
 
-func joinDirWithFilenames(dir string, files []string) {
-	if dir != "." {
-		for i, f := range files {
-			files[i] = filepath.Join(dir, f)
+func matchSegments(pattern []string, file []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(file); i++ {
+				if matchSegments(pattern[1:], file[i:]) {
+					return true
+				}
+			}
+
+			return false
 		}
+
+		if len(file) == 0 {
+			return false
+		}
+		if ok, err := path.Match(pattern[0], file[0]); err != nil || !ok {
+			return false
+		}
+
+		pattern = pattern[1:]
+		file = file[1:]
 	}
+
+	return len(file) == 0
+}
+
+// excluded reports whether the file matches any of the exclusion globs.
+func excluded(globs []string, file string) bool {
+	for _, glob := range globs {
+		if matchGlob(glob, file) {
+			return true
+		}
+	}
+
+	return false
 }
@@ -3,14 +3,14 @@ package importing
 import (
 	"fmt"
 	"github.com/VirtualRoyalty/go-mutesting/internal/models"
-	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 )
 
 func TestFilesOfArgs(t *testing.T) {
-	p := os.Getenv("GOPATH") + "/src/"
+	p := moduleDir(t)
 
 	for _, test := range []struct {
 		args   []string
@@ -19,7 +19,7 @@ func TestFilesOfArgs(t *testing.T) {
 		// empty
 		{
 			[]string{},
-			[]string{"filepath.go", "import.go"},
+			[]string{"filepath.go"},
 		},
 		// files
 		{
@@ -43,18 +43,18 @@ func TestFilesOfArgs(t *testing.T) {
 		{
 			[]string{"github.com/VirtualRoyalty/go-mutesting/internal/importing/filepathfixtures"},
 			[]string{
-				p + "github.com/VirtualRoyalty/go-mutesting/internal/importing/filepathfixtures/first.go",
-				p + "github.com/VirtualRoyalty/go-mutesting/internal/importing/filepathfixtures/second.go",
-				p + "github.com/VirtualRoyalty/go-mutesting/internal/importing/filepathfixtures/third.go",
+				p + "internal/importing/filepathfixtures/first.go",
+				p + "internal/importing/filepathfixtures/second.go",
+				p + "internal/importing/filepathfixtures/third.go",
 			},
 		},
 		{
 			[]string{"github.com/VirtualRoyalty/go-mutesting/internal/importing/filepathfixtures/..."},
 			[]string{
-				p + "github.com/VirtualRoyalty/go-mutesting/internal/importing/filepathfixtures/first.go",
-				p + "github.com/VirtualRoyalty/go-mutesting/internal/importing/filepathfixtures/second.go",
-				p + "github.com/VirtualRoyalty/go-mutesting/internal/importing/filepathfixtures/third.go",
-				p + "github.com/VirtualRoyalty/go-mutesting/internal/importing/filepathfixtures/secondfixturespackage/fourth.go",
+				p + "internal/importing/filepathfixtures/first.go",
+				p + "internal/importing/filepathfixtures/second.go",
+				p + "internal/importing/filepathfixtures/third.go",
+				p + "internal/importing/filepathfixtures/secondfixturespackage/fourth.go",
 			},
 		},
 	} {
@@ -65,8 +65,70 @@ func TestFilesOfArgs(t *testing.T) {
 	}
 }
 
+func TestFilesOfArgsWithTags(t *testing.T) {
+	var opts = &models.Options{}
+	opts.Files.Tags = "mutestingtag"
+
+	assert.Equal(t, []string{
+		"filepathfixtures/first.go",
+		"filepathfixtures/second.go",
+		"filepathfixtures/tagged.go",
+		"filepathfixtures/third.go",
+	}, FilesOfArgs([]string{"./filepathfixtures"}, opts))
+}
+
+func TestFilesOfArgsWithExclude(t *testing.T) {
+	var opts = &models.Options{}
+	opts.Files.Exclude = []string{"**/second.go"}
+	opts.Config.Exclude = []string{"**/secondfixturespackage/**"}
+
+	assert.Equal(t, []string{
+		"filepathfixtures/first.go",
+		"filepathfixtures/third.go",
+	}, FilesOfArgs([]string{"./filepathfixtures/..."}, opts))
+}
+
+func TestMatchGlob(t *testing.T) {
+	for _, test := range []struct {
+		pattern string
+		file    string
+		expect  bool
+	}{
+		{"**/mocks/**", "mocks/a.go", true},
+		{"**/mocks/**", "./a/b/mocks/c/d.go", true},
+		{"**/mocks/**", "/abs/mocks/a.go", true},
+		{"**/mocks/**", "a/mocksy/b.go", false},
+		{"**/*_gen.go", "a_gen.go", true},
+		{"**/*_gen.go", "a/b/a_gen.go", true},
+		{"**/*_gen.go", "a/b/a_gen.go.txt", false},
+		{"a/*.go", "a/b.go", true},
+		{"a/*.go", "a/b/c.go", false},
+		{"a/**/c.go", "a/c.go", true},
+		{"a/**/c.go", "b/a/c.go", false},
+	} {
+		assert.Equal(t, test.expect, matchGlob(test.pattern, test.file), fmt.Sprintf("%q with %q", test.pattern, test.file))
+	}
+}
+
+func TestCheckGlob(t *testing.T) {
+	assert.NoError(t, CheckGlob("**/mocks/**"))
+	assert.EqualError(t, CheckGlob("**/[mocks/**"), `invalid glob "**/[mocks/**": syntax error in pattern`)
+}
+
+func TestFilesOfArgsWithGenerated(t *testing.T) {
+	var opts = &models.Options{}
+	opts.Files.IncludeGenerated = true
+
+	assert.Equal(t, []string{
+		"filepathfixtures/first.go",
+		"filepathfixtures/generated.go",
+		"filepathfixtures/second.go",
+		"filepathfixtures/third.go",
+	}, FilesOfArgs([]string{"./filepathfixtures"}, opts))
+}
+
 func TestPackagesWithFilesOfArgs(t *testing.T) {
-	p := os.Getenv("GOPATH") + "/src/"
+	p := moduleDir(t)
 
 	for _, test := range []struct {
 		args   []string
@@ -75,7 +137,7 @@ func TestPackagesWithFilesOfArgs(t *testing.T) {
 		// empty
 		{
 			[]string{},
-			[]Package{{Name: ".", Files: []string{"filepath.go", "import.go"}}},
+			[]Package{{Name: ".", Files: []string{"filepath.go"}}},
 		},
 		// files
 		{
@@ -103,11 +165,11 @@ func TestPackagesWithFilesOfArgs(t *testing.T) {
 		{
 			[]string{"github.com/VirtualRoyalty/go-mutesting/internal/importing/filepathfixtures"},
 			[]Package{{
-				Name: p + "github.com/VirtualRoyalty/go-mutesting/internal/importing/filepathfixtures",
+				Name: p + "internal/importing/filepathfixtures",
 				Files: []string{
-					p + "github.com/VirtualRoyalty/go-mutesting/internal/importing/filepathfixtures/first.go",
-					p + "github.com/VirtualRoyalty/go-mutesting/internal/importing/filepathfixtures/second.go",
-					p + "github.com/VirtualRoyalty/go-mutesting/internal/importing/filepathfixtures/third.go",
+					p + "internal/importing/filepathfixtures/first.go",
+					p + "internal/importing/filepathfixtures/second.go",
+					p + "internal/importing/filepathfixtures/third.go",
 				},
 			}},
 		},
@@ -115,17 +177,17 @@ func TestPackagesWithFilesOfArgs(t *testing.T) {
 			[]string{"github.com/VirtualRoyalty/go-mutesting/internal/importing/filepathfixtures/..."},
 			[]Package{
 				{
-					Name: p + "github.com/VirtualRoyalty/go-mutesting/internal/importing/filepathfixtures",
+					Name: p + "internal/importing/filepathfixtures",
 					Files: []string{
-						p + "github.com/VirtualRoyalty/go-mutesting/internal/importing/filepathfixtures/first.go",
-						p + "github.com/VirtualRoyalty/go-mutesting/internal/importing/filepathfixtures/second.go",
-						p + "github.com/VirtualRoyalty/go-mutesting/internal/importing/filepathfixtures/third.go",
+						p + "internal/importing/filepathfixtures/first.go",
+						p + "internal/importing/filepathfixtures/second.go",
+						p + "internal/importing/filepathfixtures/third.go",
 					},
 				},
 				{
-					Name: p + "github.com/VirtualRoyalty/go-mutesting/internal/importing/filepathfixtures/secondfixturespackage",
+					Name: p + "internal/importing/filepathfixtures/secondfixturespackage",
 					Files: []string{
-						p + "github.com/VirtualRoyalty/go-mutesting/internal/importing/filepathfixtures/secondfixturespackage/fourth.go",
+						p + "internal/importing/filepathfixtures/secondfixturespackage/fourth.go",
 					},
 				},
 			},
@@ -139,7 +201,7 @@ func TestPackagesWithFilesOfArgs(t *testing.T) {
 }
 
 func TestFilesWithSkipWithoutTests(t *testing.T) {
-	p := os.Getenv("GOPATH") + "/src/"
+	p := moduleDir(t)
 
 	for _, test := range []struct {
 		args   []string
@@ -163,8 +225,8 @@ func TestFilesWithSkipWithoutTests(t *testing.T) {
 		{
 			[]string{"github.com/VirtualRoyalty/go-mutesting/internal/importing/filepathfixtures/..."},
 			[]string{
-				p + "github.com/VirtualRoyalty/go-mutesting/internal/importing/filepathfixtures/second.go",
-				p + "github.com/VirtualRoyalty/go-mutesting/internal/importing/filepathfixtures/third.go",
+				p + "internal/importing/filepathfixtures/second.go",
+				p + "internal/importing/filepathfixtures/third.go",
 			},
 		},
 	} {
@@ -177,7 +239,7 @@ func TestFilesWithSkipWithoutTests(t *testing.T) {
 }
 
 func TestFilesWithSkipWithBuildTagsTests(t *testing.T) {
-	p := os.Getenv("GOPATH") + "/src/"
+	p := moduleDir(t)
 
 	for _, test := range []struct {
 		args   []string
@@ -205,7 +267,7 @@ func TestFilesWithSkipWithBuildTagsTests(t *testing.T) {
 		{
 			[]string{"github.com/VirtualRoyalty/go-mutesting/internal/importing/filepathfixtures/..."},
 			[]string{
-				p + "github.com/VirtualRoyalty/go-mutesting/internal/importing/filepathfixtures/second.go",
+				p + "internal/importing/filepathfixtures/second.go",
 			},
 		},
 	} {
@@ -218,7 +280,7 @@ func TestFilesWithSkipWithBuildTagsTests(t *testing.T) {
 }
 
 func TestFilesWithExcludedDirs(t *testing.T) {
-	p := os.Getenv("GOPATH") + "/src/"
+	p := moduleDir(t)
 
 	for _, test := range []struct {
 		args   []string
@@ -280,21 +342,21 @@ func TestFilesWithExcludedDirs(t *testing.T) {
 		{
 			[]string{"github.com/VirtualRoyalty/go-mutesting/internal/importing/filepathfixtures/..."},
 			[]string{
-				p + "github.com/VirtualRoyalty/go-mutesting/internal/importing/filepathfixtures/first.go",
-				p + "github.com/VirtualRoyalty/go-mutesting/internal/importing/filepathfixtures/second.go",
-				p + "github.com/VirtualRoyalty/go-mutesting/internal/importing/filepathfixtures/third.go",
-				p + "github.com/VirtualRoyalty/go-mutesting/internal/importing/filepathfixtures/secondfixturespackage/fourth.go",
+				p + "internal/importing/filepathfixtures/first.go",
+				p + "internal/importing/filepathfixtures/second.go",
+				p + "internal/importing/filepathfixtures/third.go",
+				p + "internal/importing/filepathfixtures/secondfixturespackage/fourth.go",
 			},
 			[]string{"filepathfixtures"},
 		},
 		{
 			[]string{"github.com/VirtualRoyalty/go-mutesting/internal/importing/filepathfixtures/..."},
 			[]string{
-				p + "github.com/VirtualRoyalty/go-mutesting/internal/importing/filepathfixtures/first.go",
-				p + "github.com/VirtualRoyalty/go-mutesting/internal/importing/filepathfixtures/second.go",
-				p + "github.com/VirtualRoyalty/go-mutesting/internal/importing/filepathfixtures/third.go",
+				p + "internal/importing/filepathfixtures/first.go",
+				p + "internal/importing/filepathfixtures/second.go",
+				p + "internal/importing/filepathfixtures/third.go",
 			},
-			[]string{p + "github.com/VirtualRoyalty/go-mutesting/internal/importing/filepathfixtures/secondfixturespackage/"},
+			[]string{p + "internal/importing/filepathfixtures/secondfixturespackage/"},
 		},
 	} {
 		var opts = &models.Options{}
@@ -305,3 +367,11 @@ func TestFilesWithExcludedDirs(t *testing.T) {
 		assert.Equal(t, test.expect, got, fmt.Sprintf("With args: %#v", test.args))
 	}
 }
+
+// moduleDir returns the root directory of the module which import paths are resolved to.
+func moduleDir(t *testing.T) string {
+	dir, err := filepath.Abs("../..")
+	assert.NoError(t, err)
+
+	return dir + "/"
+}
@@ -0,0 +1,7 @@
+//go:build mutestingtag
+
+package filepathfixtures
+
+func tagged() int {
+	return 1
+}
@@ -0,0 +1,59 @@
+package models
+
+import (
+	"fmt"
+)
+
+// BadgeReportFileName File name for shields.io badge
+var BadgeReportFileName string = "badge.json"
+
+const (
+	badgeSchemaVersion = 1
+	badgeLabel         = "mutation score"
+)
+
+// Colors of the badge
+const (
+	BadgeColorPassed  = "brightgreen"
+	BadgeColorWarning = "yellow"
+	BadgeColorFailed  = "red"
+)
+
+// Thresholds of the colors of the badge if the report has no minimum mutation score
+const (
+	badgePassedMsi  = 0.8
+	badgeWarningMsi = 0.6
+)
+
+// Badge Structure for the endpoint JSON of a shields.io badge, see https://shields.io/badges/endpoint-badge
+type Badge struct {
+	SchemaVersion int    `json:"schemaVersion"`
+	Label         string `json:"label"`
+	Message       string `json:"message"`
+	Color         string `json:"color"`
+}
+
+// Badge returns the shields.io badge of the mutation score.
+// The badge is green if the score reaches the minimum mutation score of the report and red otherwise, reports without a minimum use fixed thresholds.
+func (report *Report) Badge() Badge {
+	msi := report.Stats.Msi
+
+	color := BadgeColorFailed
+	switch {
+	case report.MinMsi > 0:
+		if msi >= report.MinMsi {
+			color = BadgeColorPassed
+		}
+	case msi >= badgePassedMsi:
+		color = BadgeColorPassed
+	case msi >= badgeWarningMsi:
+		color = BadgeColorWarning
+	}
+
+	return Badge{
+		SchemaVersion: badgeSchemaVersion,
+		Label:         badgeLabel,
+		Message:       fmt.Sprintf("%.1f%%", msi*100),
+		Color:         color,
+	}
+}
@@ -0,0 +1,36 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReportBadge(t *testing.T) {
+	for name, tc := range map[string]struct {
+		msi    float64
+		minMsi float64
+		color  string
+	}{
+		"passed without minimum":  {msi: 0.85, color: BadgeColorPassed},
+		"warning without minimum": {msi: 0.7, color: BadgeColorWarning},
+		"failed without minimum":  {msi: 0.4, color: BadgeColorFailed},
+		"passed with minimum":     {msi: 0.5, minMsi: 0.5, color: BadgeColorPassed},
+		"failed with minimum":     {msi: 0.85, minMsi: 0.9, color: BadgeColorFailed},
+	} {
+		t.Run(name, func(t *testing.T) {
+			report := &Report{
+				Stats:  Stats{Msi: tc.msi},
+				MinMsi: tc.minMsi,
+			}
+
+			assert.Equal(t, tc.color, report.Badge().Color)
+		})
+	}
+
+	report := &Report{Stats: Stats{Msi: 0.8125}}
+	content, err := json.Marshal(report.Badge())
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"schemaVersion":1,"label":"mutation score","message":"81.2%","color":"brightgreen"}`, string(content))
+}
@@ -0,0 +1,110 @@
+package models
+
+import (
+	"encoding/json"
+	"os"
+)
+
+// Baseline holds a previous report whose escaped mutants are executed again
+type Baseline struct {
+	report   *Report
+	executed map[string]bool
+}
+
+// ReadBaseline reads a previous JSON report
+func ReadBaseline(fileName string) (*Baseline, error) {
+	content, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{}
+	err = json.Unmarshal(content, report)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &Baseline{
+		report:   report,
+		executed: map[string]bool{},
+	}
+	for _, mutant := range report.Escaped {
+		b.executed[baselineKey(mutant.Mutator.OriginalFilePath, mutant.Mutator.MutatedSourceCode)] = false
+	}
+
+	return b, nil
+}
+
+// EscapedCount returns the count of escaped mutants of the baseline
+func (b *Baseline) EscapedCount() int {
+	return len(b.report.Escaped)
+}
+
+// Escaped checks if the mutation of the file escaped in the baseline and marks it to be executed again
+func (b *Baseline) Escaped(originalFile string, mutatedSourceCode string) bool {
+	key := baselineKey(originalFile, mutatedSourceCode)
+
+	if _, ok := b.executed[key]; !ok {
+		return false
+	}
+	b.executed[key] = true
+
+	return true
+}
+
+// Merge replaces the escaped mutants of the baseline which were executed again with the results of the given report.
+// Escaped mutants which were not executed again, e.g. because their file has changed, are kept.
+func (b *Baseline) Merge(report *Report) *Report {
+	merged := b.report
+
+	var escaped []Mutant
+	for _, mutant := range merged.Escaped {
+		if !b.executed[baselineKey(mutant.Mutator.OriginalFilePath, mutant.Mutator.MutatedSourceCode)] {
+			escaped = append(escaped, mutant)
+
+			continue
+		}
+
+		merged.Stats.EscapedCount--
+		if stats, ok := merged.Files[mutant.Mutator.OriginalFilePath]; ok {
+			stats.EscapedCount--
+		}
+		if mutant.Mutator.Function != "" {
+			merged.Function(mutant.Mutator.OriginalFilePath, mutant.Mutator.Function).EscapedCount--
+		}
+	}
+	merged.Escaped = escaped
+
+	merged.Escaped = append(merged.Escaped, report.Escaped...)
+	merged.Timeouted = append(merged.Timeouted, report.Timeouted...)
+	merged.Killed = append(merged.Killed, report.Killed...)
+	merged.Errored = append(merged.Errored, report.Errored...)
+	merged.CaughtByVet = append(merged.CaughtByVet, report.CaughtByVet...)
+	merged.NotCovered = append(merged.NotCovered, report.NotCovered...)
+
+	merged.Stats.add(&report.Stats)
+	for file, stats := range report.Files {
+		merged.File(file).add(stats)
+	}
+	for _, stats := range report.Functions {
+		merged.Function(stats.File, stats.Function).add(&stats.Stats)
+	}
+
+	return merged
+}
+
+// add adds the counts of the given stats
+func (stats *Stats) add(other *Stats) {
+	stats.KilledCount += other.KilledCount
+	stats.NotCoveredCount += other.NotCoveredCount
+	stats.EscapedCount += other.EscapedCount
+	stats.ErrorCount += other.ErrorCount
+	stats.SkippedCount += other.SkippedCount
+	stats.TimeOutCount += other.TimeOutCount
+	stats.CaughtByVetCount += other.CaughtByVetCount
+	stats.DuplicatedCount += other.DuplicatedCount
+}
+
+func baselineKey(originalFile string, mutatedSourceCode string) string {
+	return originalFile + "\x00" + mutatedSourceCode
+}
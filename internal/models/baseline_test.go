@@ -0,0 +1,112 @@
+package models
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBaseline(t *testing.T) {
+	previous := &Report{
+		Escaped: []Mutant{
+			{
+				Mutator: Mutator{
+					OriginalFilePath:  "a.go",
+					MutatedSourceCode: "a0",
+				},
+			},
+			{
+				Mutator: Mutator{
+					OriginalFilePath:  "a.go",
+					MutatedSourceCode: "a1",
+				},
+			},
+			{
+				Mutator: Mutator{
+					OriginalFilePath:  "b.go",
+					MutatedSourceCode: "b0",
+				},
+			},
+		},
+		Killed: []Mutant{
+			{
+				Mutator: Mutator{
+					OriginalFilePath:  "a.go",
+					MutatedSourceCode: "a2",
+				},
+			},
+		},
+	}
+	previous.Stats = Stats{
+		KilledCount:  1,
+		EscapedCount: 3,
+	}
+	previous.File("a.go").KilledCount = 1
+	previous.File("a.go").EscapedCount = 2
+	previous.File("b.go").EscapedCount = 1
+
+	content, err := json.Marshal(previous)
+	assert.NoError(t, err)
+
+	fileName := filepath.Join(t.TempDir(), "report.json")
+	assert.NoError(t, os.WriteFile(fileName, content, 0666))
+
+	baseline, err := ReadBaseline(fileName)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, baseline.EscapedCount())
+
+	assert.True(t, baseline.Escaped("a.go", "a0"))
+	assert.True(t, baseline.Escaped("a.go", "a1"))
+	assert.False(t, baseline.Escaped("a.go", "a2"))
+	assert.False(t, baseline.Escaped("b.go", "a0"))
+
+	report := &Report{
+		Escaped: []Mutant{
+			{
+				Mutator: Mutator{
+					OriginalFilePath:  "a.go",
+					MutatedSourceCode: "a1",
+				},
+			},
+		},
+		Killed: []Mutant{
+			{
+				Mutator: Mutator{
+					OriginalFilePath:  "a.go",
+					MutatedSourceCode: "a0",
+				},
+			},
+		},
+	}
+	report.Stats = Stats{
+		KilledCount:  1,
+		EscapedCount: 1,
+	}
+	report.File("a.go").KilledCount = 1
+	report.File("a.go").EscapedCount = 1
+
+	merged := baseline.Merge(report)
+	merged.Calculate()
+
+	assert.Equal(t, int64(2), merged.Stats.KilledCount)
+	assert.Equal(t, int64(2), merged.Stats.EscapedCount)
+	assert.Equal(t, int64(4), merged.Stats.TotalMutantsCount)
+	assert.Equal(t, int64(2), merged.Files["a.go"].KilledCount)
+	assert.Equal(t, int64(1), merged.Files["a.go"].EscapedCount)
+	assert.Equal(t, int64(1), merged.Files["b.go"].EscapedCount)
+
+	var escaped []string
+	for _, mutant := range merged.Escaped {
+		escaped = append(escaped, mutant.Mutator.MutatedSourceCode)
+	}
+	assert.Equal(t, []string{"b0", "a1"}, escaped)
+	assert.Len(t, merged.Killed, 2)
+}
+
+func TestReadBaselineNotExisting(t *testing.T) {
+	_, err := ReadBaseline(filepath.Join(t.TempDir(), "report.json"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,69 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"time"
+)
+
+// HistoryEntry holds the mutation score and counts of one run in the history file of --history-file
+type HistoryEntry struct {
+	Time time.Time `json:"time"`
+	// Commit is the SHA of the checked out git commit, it is empty outside of git repositories
+	Commit       string            `json:"commit,omitempty"`
+	RunName      string            `json:"runName,omitempty"`
+	Labels       map[string]string `json:"labels,omitempty"`
+	Msi          float64           `json:"msi"`
+	KilledCount  int64             `json:"killedCount"`
+	EscapedCount int64             `json:"escapedCount"`
+	TotalCount   int64             `json:"totalCount"`
+}
+
+// NewHistoryEntry returns the history entry of the report of the run at the given commit
+func NewHistoryEntry(report *Report, commit string, t time.Time) HistoryEntry {
+	return HistoryEntry{
+		Time:         t,
+		Commit:       commit,
+		RunName:      report.RunName,
+		Labels:       report.Labels,
+		Msi:          report.Stats.Msi,
+		KilledCount:  report.Stats.KilledCount,
+		EscapedCount: report.Stats.EscapedCount,
+		TotalCount:   report.Stats.TotalMutantsCount,
+	}
+}
+
+// ReadHistory reads the entries of a history file, a missing file has no entries
+func ReadHistory(fileName string) ([]HistoryEntry, error) {
+	content, err := os.ReadFile(fileName)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var entries []HistoryEntry
+	err = json.Unmarshal(content, &entries)
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// AppendHistory appends the entry to the entries of a history file, the file is created if it does not exist
+func AppendHistory(fileName string, entry HistoryEntry) error {
+	entries, err := ReadHistory(fileName)
+	if err != nil {
+		return err
+	}
+
+	content, err := json.MarshalIndent(append(entries, entry), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(fileName, append(content, '\n'), 0666)
+}
@@ -0,0 +1,50 @@
+package models
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistory(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "msi-history.json")
+
+	entries, err := ReadHistory(fileName)
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+
+	report := &Report{
+		RunName: "nightly",
+		Stats: Stats{
+			KilledCount:  3,
+			EscapedCount: 1,
+		},
+	}
+	report.Calculate()
+
+	first := NewHistoryEntry(report, "abc", time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC))
+	assert.Equal(t, HistoryEntry{
+		Time:         time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC),
+		Commit:       "abc",
+		RunName:      "nightly",
+		Msi:          0.75,
+		KilledCount:  3,
+		EscapedCount: 1,
+		TotalCount:   4,
+	}, first)
+	assert.NoError(t, AppendHistory(fileName, first))
+
+	second := first
+	second.Commit = "def"
+	assert.NoError(t, AppendHistory(fileName, second))
+
+	entries, err = ReadHistory(fileName)
+	assert.NoError(t, err)
+	assert.Equal(t, []HistoryEntry{first, second}, entries)
+
+	assert.NoError(t, os.WriteFile(fileName, []byte("{"), 0666))
+	assert.Error(t, AppendHistory(fileName, first))
+}
@@ -0,0 +1,107 @@
+package models
+
+import (
+	"fmt"
+	"html/template"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// HTMLReportFileName File name for HTML report
+var HTMLReportFileName string = "report.html"
+
+var htmlTemplate = template.Must(template.New("report").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Mutation testing report</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+table { border-collapse: collapse; }
+th, td { border: 1px solid #ccc; padding: 0.3em 0.6em; }
+td.number { text-align: right; }
+pre { background: #f6f8fa; padding: 0.6em; }
+</style>
+</head>
+<body>
+<h1>Mutation testing report</h1>
+<p><strong>Mutation score: {{printf "%.2f" .Stats.Msi}}</strong> ({{.Stats.KilledCount}} killed, {{.Stats.EscapedCount}} escaped, {{.Stats.SkippedCount}} skipped, {{.Stats.ErrorCount}} errored, total is {{.Stats.TotalMutantsCount}})</p>
+{{- if .Packages}}
+<table>
+<tr><th>Package</th><th>Killed</th><th>Escaped</th><th>Skipped</th><th>Total</th><th>MSI</th></tr>
+{{- range .Packages}}
+<tr><td>{{.Name}}</td><td class="number">{{.Stats.KilledCount}}</td><td class="number">{{.Stats.EscapedCount}}</td><td class="number">{{.Stats.SkippedCount}}</td><td class="number">{{.Stats.TotalMutantsCount}}</td><td class="number">{{printf "%.2f" .Stats.Msi}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+{{- if .Escaped}}
+<h2>Escaped mutants ({{len .Escaped}})</h2>
+{{- range .Escaped}}
+<details>
+<summary><code>{{.Mutator}}</code> in <code>{{.Location}}</code></summary>
+<pre>{{.Diff}}</pre>
+</details>
+{{- end}}
+{{- end}}
+</body>
+</html>
+`))
+
+type htmlPackage struct {
+	Name  string
+	Stats *Stats
+}
+
+type htmlMutant struct {
+	Mutator  string
+	Location string
+	Diff     string
+}
+
+// HTML renders a standalone HTML page of the report with the stats of every package and the diffs of all escaped mutants
+func (report *Report) HTML() (string, error) {
+	data := struct {
+		Stats    Stats
+		Packages []htmlPackage
+		Escaped  []htmlMutant
+	}{
+		Stats: report.Stats,
+	}
+
+	for name, stats := range report.packages() {
+		data.Packages = append(data.Packages, htmlPackage{
+			Name:  name,
+			Stats: stats,
+		})
+	}
+	sort.Slice(data.Packages, func(i, j int) bool {
+		return data.Packages[i].Name < data.Packages[j].Name
+	})
+
+	for _, mutant := range report.Escaped {
+		data.Escaped = append(data.Escaped, htmlMutant{
+			Mutator:  mutant.Mutator.MutatorName,
+			Location: mutantLocation(mutant),
+			Diff:     strings.TrimSuffix(mutant.Diff, "\n"),
+		})
+	}
+
+	var b strings.Builder
+	err := htmlTemplate.Execute(&b, data)
+	if err != nil {
+		return "", err
+	}
+
+	return b.String(), nil
+}
+
+// mutantLocation returns the mutated file of the mutant with its line if it is known
+func mutantLocation(mutant Mutant) string {
+	location := filepath.ToSlash(mutant.Mutator.OriginalFilePath)
+	if mutant.Mutator.OriginalStartLine > 0 {
+		location = fmt.Sprintf("%s:%d", location, mutant.Mutator.OriginalStartLine)
+	}
+
+	return location
+}
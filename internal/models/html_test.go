@@ -0,0 +1,41 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReportHTML(t *testing.T) {
+	report := &Report{
+		Escaped: []Mutant{
+			{
+				Mutator: Mutator{
+					MutatorName:       "expression/comparison",
+					OriginalFilePath:  "example/example.go",
+					OriginalStartLine: 12,
+				},
+				Diff: "--- Original\n+++ New\n-\tif a < b {\n+\tif a <= b {\n",
+			},
+		},
+	}
+	report.File("example/example.go").KilledCount = 3
+	report.File("example/example.go").EscapedCount = 1
+	report.File("example/sub/sub.go").KilledCount = 2
+	report.Stats = Stats{
+		KilledCount:  5,
+		EscapedCount: 1,
+	}
+	report.Calculate()
+
+	html, err := report.HTML()
+	assert.NoError(t, err)
+
+	assert.Contains(t, html, "<strong>Mutation score: 0.83</strong> (5 killed, 1 escaped, 0 skipped, 0 errored, total is 6)")
+	assert.Contains(t, html, `<tr><td>example</td><td class="number">3</td><td class="number">1</td><td class="number">0</td><td class="number">4</td><td class="number">0.75</td></tr>`)
+	assert.Contains(t, html, "<tr><td>example/sub</td>")
+	assert.Contains(t, html, "<h2>Escaped mutants (1)</h2>")
+	assert.Contains(t, html, "<summary><code>expression/comparison</code> in <code>example/example.go:12</code></summary>")
+	// The diff is escaped
+	assert.Contains(t, html, "-\tif a &lt; b {\n&#43;\tif a &lt;= b {</pre>")
+}
@@ -0,0 +1,92 @@
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// MarkdownReportFileName File name for markdown report
+var MarkdownReportFileName string = "report.md"
+
+// markdownTopEscaped count of escaped mutants which are shown with their diffs
+const markdownTopEscaped = 10
+
+// Markdown renders a compact markdown summary of the report which is suitable for PR comments
+func (report *Report) Markdown() string {
+	var b strings.Builder
+
+	b.WriteString("## Mutation testing report\n\n")
+	fmt.Fprintf(&b, "**Mutation score: %.2f** (%d killed, %d escaped, %d skipped, %d errored, total is %d)\n",
+		report.Stats.Msi,
+		report.Stats.KilledCount,
+		report.Stats.EscapedCount,
+		report.Stats.SkippedCount,
+		report.Stats.ErrorCount,
+		report.Stats.TotalMutantsCount,
+	)
+
+	packages := report.packages()
+	if len(packages) != 0 {
+		names := make([]string, 0, len(packages))
+		for name := range packages {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		b.WriteString("\n| Package | Killed | Escaped | Skipped | Total | MSI |\n")
+		b.WriteString("| :------ | -----: | ------: | ------: | ----: | --: |\n")
+		for _, name := range names {
+			stats := packages[name]
+
+			fmt.Fprintf(&b, "| %s | %d | %d | %d | %d | %.2f |\n",
+				markdownEscape(name),
+				stats.KilledCount,
+				stats.EscapedCount,
+				stats.SkippedCount,
+				stats.TotalMutantsCount,
+				stats.Msi,
+			)
+		}
+	}
+
+	if len(report.Escaped) != 0 {
+		top := report.Escaped
+		if len(top) > markdownTopEscaped {
+			top = top[:markdownTopEscaped]
+		}
+
+		fmt.Fprintf(&b, "\n### Escaped mutants (%d of %d)\n\n", len(top), len(report.Escaped))
+		for _, mutant := range top {
+			fmt.Fprintf(&b, "<details>\n<summary><code>%s</code> in <code>%s</code></summary>\n\n",
+				mutant.Mutator.MutatorName,
+				mutantLocation(mutant),
+			)
+			b.WriteString("```diff\n")
+			b.WriteString(strings.TrimSuffix(mutant.Diff, "\n"))
+			b.WriteString("\n```\n\n</details>\n")
+		}
+	}
+
+	return b.String()
+}
+
+// packages returns the stats of every package, reports without them aggregate the stats of the mutated files by their directory
+func (report *Report) packages() map[string]*Stats {
+	if report.Packages != nil {
+		return report.Packages
+	}
+
+	aggregated := &Report{Files: report.Files}
+	aggregated.AggregatePackages(nil)
+	for _, stats := range aggregated.Packages {
+		stats.calculate(report.ExcludeNotCovered)
+	}
+
+	return aggregated.Packages
+}
+
+// markdownEscape escapes characters which would break a markdown table cell
+func markdownEscape(s string) string {
+	return strings.ReplaceAll(s, "|", "\\|")
+}
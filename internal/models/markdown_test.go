@@ -0,0 +1,74 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReportMarkdown(t *testing.T) {
+	report := &Report{
+		Escaped: []Mutant{
+			{
+				Mutator: Mutator{
+					MutatorName:       "branch/if",
+					OriginalFilePath:  "example/example.go",
+					OriginalStartLine: 12,
+				},
+				Diff: "--- Original\n+++ New\n-\tfoo()\n+\t_ = foo\n",
+			},
+		},
+	}
+	report.File("example/example.go").KilledCount = 3
+	report.File("example/example.go").EscapedCount = 1
+	report.File("example/sub/sub.go").KilledCount = 2
+	report.File("example/sub/a.go").SkippedCount = 2
+	report.Stats = Stats{
+		KilledCount:  5,
+		EscapedCount: 1,
+		SkippedCount: 2,
+	}
+	report.Calculate()
+
+	assert.Equal(t, "## Mutation testing report\n"+
+		"\n"+
+		"**Mutation score: 0.88** (5 killed, 1 escaped, 2 skipped, 0 errored, total is 8)\n"+
+		"\n"+
+		"| Package | Killed | Escaped | Skipped | Total | MSI |\n"+
+		"| :------ | -----: | ------: | ------: | ----: | --: |\n"+
+		"| example | 3 | 1 | 0 | 4 | 0.75 |\n"+
+		"| example/sub | 2 | 0 | 2 | 4 | 1.00 |\n"+
+		"\n"+
+		"### Escaped mutants (1 of 1)\n"+
+		"\n"+
+		"<details>\n"+
+		"<summary><code>branch/if</code> in <code>example/example.go:12</code></summary>\n"+
+		"\n"+
+		"```diff\n"+
+		"--- Original\n+++ New\n-\tfoo()\n+\t_ = foo\n"+
+		"```\n"+
+		"\n"+
+		"</details>\n",
+		report.Markdown(),
+	)
+}
+
+func TestReportMarkdownTopEscaped(t *testing.T) {
+	report := &Report{}
+	for i := 0; i < markdownTopEscaped+5; i++ {
+		report.Escaped = append(report.Escaped, Mutant{
+			Mutator: Mutator{
+				MutatorName:      "statement/remove",
+				OriginalFilePath: fmt.Sprintf("file%d.go", i),
+			},
+		})
+	}
+
+	markdown := report.Markdown()
+
+	assert.Contains(t, markdown, fmt.Sprintf("### Escaped mutants (%d of %d)", markdownTopEscaped, markdownTopEscaped+5))
+	assert.Equal(t, markdownTopEscaped, strings.Count(markdown, "<details>"))
+	assert.NotContains(t, markdown, "| Package |")
+}
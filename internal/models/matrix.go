@@ -0,0 +1,199 @@
+package models
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+)
+
+// KillMatrix records which tests killed which mutants
+type KillMatrix struct {
+	rows  []killMatrixRow
+	tests map[string]struct{}
+}
+
+type killMatrixRow struct {
+	checksum string
+	mutant   Mutant
+	// results maps the executed tests to true if they killed the mutant
+	results map[string]bool
+}
+
+// NewKillMatrix creates an empty kill matrix
+func NewKillMatrix() *KillMatrix {
+	return &KillMatrix{
+		tests: map[string]struct{}{},
+	}
+}
+
+// Add records the executed tests of the mutant and which of them killed it
+func (m *KillMatrix) Add(checksum string, mutant Mutant, executed []string, killed []string) {
+	row := killMatrixRow{
+		checksum: checksum,
+		mutant:   mutant,
+		results:  map[string]bool{},
+	}
+
+	for _, test := range executed {
+		row.results[test] = false
+		m.tests[test] = struct{}{}
+	}
+	for _, test := range killed {
+		row.results[test] = true
+		m.tests[test] = struct{}{}
+	}
+
+	m.rows = append(m.rows, row)
+}
+
+// WriteCSV writes the matrix with a row for every mutant and a column for every test.
+// A cell is 1 if the test killed the mutant, 0 if the test was executed without killing it and empty if the test was not executed.
+func (m *KillMatrix) WriteCSV(w io.Writer) error {
+	tests := make([]string, 0, len(m.tests))
+	for test := range m.tests {
+		tests = append(tests, test)
+	}
+	sort.Strings(tests)
+
+	cw := csv.NewWriter(w)
+
+	err := cw.Write(append([]string{"checksum", "file", "line", "mutator"}, tests...))
+	if err != nil {
+		return err
+	}
+
+	for _, row := range m.rows {
+		record := []string{
+			row.checksum,
+			row.mutant.Mutator.OriginalFilePath,
+			fmt.Sprintf("%d", row.mutant.Mutator.OriginalStartLine),
+			row.mutant.Mutator.MutatorName,
+		}
+
+		for _, test := range tests {
+			killed, ok := row.results[test]
+			switch {
+			case !ok:
+				record = append(record, "")
+			case killed:
+				record = append(record, "1")
+			default:
+				record = append(record, "0")
+			}
+		}
+
+		err = cw.Write(record)
+		if err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+
+	return cw.Error()
+}
+
+// ReadKillMatrixCSV reads a matrix which was written with WriteCSV
+func ReadKillMatrixCSV(r io.Reader) (*KillMatrix, error) {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 || len(records[0]) < 4 {
+		return nil, fmt.Errorf("kill matrix has no header")
+	}
+
+	tests := records[0][4:]
+
+	m := NewKillMatrix()
+	for _, test := range tests {
+		m.tests[test] = struct{}{}
+	}
+
+	for i, record := range records[1:] {
+		line, err := strconv.ParseInt(record[2], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid line in row %d: %v", i+2, err)
+		}
+
+		row := killMatrixRow{
+			checksum: record[0],
+			mutant: Mutant{
+				Mutator: Mutator{
+					MutatorName:       record[3],
+					OriginalFilePath:  record[1],
+					OriginalStartLine: line,
+				},
+			},
+			results: map[string]bool{},
+		}
+
+		for j, test := range tests {
+			switch record[4+j] {
+			case "":
+			case "1":
+				row.results[test] = true
+			case "0":
+				row.results[test] = false
+			default:
+				return nil, fmt.Errorf("invalid cell %q of test %q in row %d", record[4+j], test, i+2)
+			}
+		}
+
+		m.rows = append(m.rows, row)
+	}
+
+	return m, nil
+}
+
+// KillMatrixSuggestions are suggestions for the maintenance of a test suite derived from a kill matrix
+type KillMatrixSuggestions struct {
+	// RedundantTests kill no mutant which is not killed by another test as well.
+	// Every one of them can be removed on its own without letting a mutant escape, but not necessarily all of them together.
+	RedundantTests []string
+	// FragileMutants are killed by only one test.
+	FragileMutants []FragileMutant
+}
+
+// FragileMutant is a mutant which is killed by only one test
+type FragileMutant struct {
+	Checksum string
+	Mutant   Mutant
+	Test     string
+}
+
+// Suggest returns the suggestions for the tests and mutants of the matrix
+func (m *KillMatrix) Suggest() KillMatrixSuggestions {
+	var suggestions KillMatrixSuggestions
+
+	unique := map[string]bool{}
+	for _, row := range m.rows {
+		var killers []string
+		for test, killed := range row.results {
+			if killed {
+				killers = append(killers, test)
+			}
+		}
+
+		if len(killers) == 1 {
+			unique[killers[0]] = true
+
+			suggestions.FragileMutants = append(suggestions.FragileMutants, FragileMutant{
+				Checksum: row.checksum,
+				Mutant:   row.mutant,
+				Test:     killers[0],
+			})
+		}
+	}
+
+	for test := range m.tests {
+		if !unique[test] {
+			suggestions.RedundantTests = append(suggestions.RedundantTests, test)
+		}
+	}
+	sort.Strings(suggestions.RedundantTests)
+
+	return suggestions
+}
@@ -0,0 +1,73 @@
+package models
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKillMatrixWriteCSV(t *testing.T) {
+	matrix := NewKillMatrix()
+
+	matrix.Add("abc", Mutant{
+		Mutator: Mutator{
+			MutatorName:       "branch/if",
+			OriginalFilePath:  "calc/calc.go",
+			OriginalStartLine: 5,
+		},
+	}, []string{"calc.TestAdd", "calc.TestAddSub"}, []string{"calc.TestAdd"})
+	matrix.Add("def", Mutant{
+		Mutator: Mutator{
+			MutatorName:       "statement/remove",
+			OriginalFilePath:  "calc/calc.go",
+			OriginalStartLine: 10,
+		},
+	}, []string{"calc.TestSub"}, nil)
+
+	var buf bytes.Buffer
+	assert.NoError(t, matrix.WriteCSV(&buf))
+
+	assert.Equal(t, ""+
+		"checksum,file,line,mutator,calc.TestAdd,calc.TestAddSub,calc.TestSub\n"+
+		"abc,calc/calc.go,5,branch/if,1,0,\n"+
+		"def,calc/calc.go,10,statement/remove,,,0\n",
+		buf.String(),
+	)
+}
+
+func TestKillMatrixSuggest(t *testing.T) {
+	matrix, err := ReadKillMatrixCSV(strings.NewReader("" +
+		"checksum,file,line,mutator,calc.TestAdd,calc.TestAddSub,calc.TestSub\n" +
+		"abc,calc/calc.go,5,branch/if,1,0,\n" +
+		"def,calc/calc.go,10,statement/remove,,1,1\n" +
+		"ghi,calc/calc.go,12,statement/remove,,0,0\n",
+	))
+	assert.NoError(t, err)
+
+	suggestions := matrix.Suggest()
+
+	assert.Equal(t, []string{"calc.TestAddSub", "calc.TestSub"}, suggestions.RedundantTests)
+	assert.Equal(t, []FragileMutant{
+		{
+			Checksum: "abc",
+			Mutant: Mutant{
+				Mutator: Mutator{
+					MutatorName:       "branch/if",
+					OriginalFilePath:  "calc/calc.go",
+					OriginalStartLine: 5,
+				},
+			},
+			Test: "calc.TestAdd",
+		},
+	}, suggestions.FragileMutants)
+}
+
+func TestReadKillMatrixCSVInvalidCell(t *testing.T) {
+	_, err := ReadKillMatrixCSV(strings.NewReader("" +
+		"checksum,file,line,mutator,calc.TestAdd\n" +
+		"abc,calc/calc.go,5,branch/if,x\n",
+	))
+	assert.EqualError(t, err, `invalid cell "x" of test "calc.TestAdd" in row 2`)
+}
@@ -1,5 +1,9 @@
 package models
 
+import (
+	"time"
+)
+
 // Options Main config structure
 type Options struct {
 	General struct {
@@ -8,31 +12,82 @@ type Options struct {
 		Help                 bool   `long:"help" description:"Show this help message"`
 		Verbose              bool   `long:"verbose" description:"Verbose log output"`
 		Config               string `long:"config" description:"Path to config file"`
+		Console              string `long:"console" description:"Render the console output as colored or plain text, as JSON lines or as a single progress line" choice:"color" choice:"plain" choice:"json" choice:"progress" default:"color"`
+		JSON                 bool   `long:"json" description:"Suppress the console output and write the JSON report to the standard output instead of report.json, e.g. to pipe it into jq"`
+		ProfileEngine        string `long:"profile-engine" description:"Write a pprof CPU profile and optionally a memory profile of go-mutesting itself into these comma separated files, e.g. cpu.out,mem.out, and print how long the engine and the tests took"`
 	} `group:"General options"`
 
 	Files struct {
-		Blacklist []string `long:"blacklist" description:"List of MD5 checksums of mutations which should be ignored. Each checksum must end with a new line character."`
-		ListFiles bool     `long:"list-files" description:"List found files"`
-		PrintAST  bool     `long:"print-ast" description:"Print the ASTs of all given files and exit"`
+		Blacklist        []string `long:"blacklist" description:"List of MD5 or SHA-256 checksums of mutations which should be ignored. Each checksum must end with a new line character."`
+		Checksum         string   `long:"checksum" description:"Algorithm of the checksums of mutations, sha256 hashes the mutator, position and source code of a mutation while md5 hashes only its source code just like earlier versions" choice:"sha256" choice:"md5" default:"sha256"`
+		ListFiles        bool     `long:"list-files" description:"List found files"`
+		PrintAST         bool     `long:"print-ast" description:"Print the ASTs of all given files and exit"`
+		Exclude          []string `long:"exclude" description:"Do not mutate files which match this glob, \"**\" matches any count of directories, e.g. \"**/mocks/**\" (can be given multiple times)"`
+		IncludeGenerated bool     `long:"include-generated" description:"Mutate generated files with a \"// Code generated ... DO NOT EDIT.\" comment as well"`
+		Tags             string   `long:"tags" description:"Comma separated list of build tags which are taken into account for finding, type-checking and testing files just like go build -tags"`
 	} `group:"File options"`
 
 	Mutator struct {
 		DisableMutators []string `long:"disable" description:"Disable mutator by their name or using * as a suffix pattern (in order to check remaining enabled mutators use --verbose option)"`
+		EnableMutators  []string `long:"enable" description:"Enable mutator which is disabled by default by their name or using * as a suffix pattern"`
+		Packs           []string `long:"packs" description:"Enable only the mutators of these packs, which are defined by the packs config parameter, separated by commas (can be given multiple times)"`
 		ListMutators    bool     `long:"list-mutators" description:"List all available mutators (including disabled)"`
+		MutatorPlugins  []string `long:"mutator-plugin" description:"Load the mutators of this Go plugin which exports \"func Mutators() map[string]mutator.Mutator\" (can be given multiple times)"`
 	} `group:"Mutator options"`
 
 	Filter struct {
-		Match string `long:"match" description:"Only functions are mutated that confirm to the arguments regex"`
+		Match                string  `long:"match" description:"Only functions are mutated that confirm to the arguments regex"`
+		GitDiff              string  `long:"git-diff" description:"Only mutate code which is changed compared to this git ref, e.g. main or HEAD~1"`
+		Staged               bool    `long:"staged" description:"Only mutate code which is staged for the next commit, e.g. in a git pre-commit hook"`
+		ChangedFunctions     bool    `long:"changed-functions" description:"Mutate the whole functions which contain changed lines of --git-diff or --staged instead of only the changed lines"`
+		Baseline             string  `long:"baseline" description:"Execute only the mutations which escaped in this previous JSON report and merge their results into it"`
+		SampleRate           float64 `long:"sample-rate" description:"Execute only a random sample of the mutations with this rate, e.g. 0.2 for 20%, the sample is the same for every run with the same seed" default:"1"`
+		Seed                 int64   `long:"seed" description:"Seed of the random sample of --sample-rate" default:"0"`
+		SkipFilesOver        string  `long:"skip-files-over" description:"Do not mutate files which are larger than this size in bytes or with a KB, MB or GB suffix, e.g. 200KB"`
+		SkipFilesOverMutants int     `long:"skip-files-over-mutants" description:"Do not mutate files with more mutations than this count, e.g. 500"`
+		CoverProfile         string  `long:"coverprofile" description:"Skip mutations of code which is not covered according to this coverage profile of \"go test -coverprofile\""`
 	} `group:"Filter options"`
 
 	Exec struct {
-		Exec    string `long:"exec" description:"Execute this command for every mutation (by default the built-in exec command is used)"`
-		NoExec  bool   `long:"no-exec" description:"Skip the built-in exec command and just generate the mutations"`
-		Timeout uint   `long:"exec-timeout" description:"Sets a timeout for the command execution (in seconds)" default:"10"`
+		Exec        string        `long:"exec" description:"Execute this command for every mutation (by default the built-in exec command is used)"`
+		NoExec      bool          `long:"no-exec" description:"Skip the built-in exec command and just generate the mutations"`
+		DryRun      bool          `long:"dry-run" description:"Only print every mutation which would be executed with its position, mutator and changed line without writing the mutations or executing any tests"`
+		Timeout     uint          `long:"exec-timeout" description:"Sets a timeout for the command execution (in seconds)" default:"10"`
+		GoMaxProcs  int           `long:"exec-gomaxprocs" description:"Set GOMAXPROCS for the tests of every mutation to limit the CPU cores used by building and executing them"`
+		Nice        int           `long:"exec-nice" description:"Execute the tests of every mutation with this lower scheduling priority between 1 and 19 using nice, on Windows every level means below normal priority"`
+		Vet         bool          `long:"exec-vet" description:"Execute go vet for every mutation before its tests, mutations which go vet reports are counted as caught by static analysis without executing their tests"`
+		Workers     int           `long:"workers" description:"Count of mutations which are executed concurrently, each worker executes its mutations in its own copy of the module" default:"1"`
+		BuildSystem string        `long:"exec-build-system" description:"Execute the tests of the built-in exec command with this build system instead of go test" choice:"bazel" choice:"please"`
+		Target      string        `long:"exec-target" description:"Target which is tested by the build system, {dir} is replaced by the directory of the mutated file relative to the workspace root" default:"//{dir}:all"`
+		FailFast    bool          `long:"fail-fast" description:"Stop executing mutations after the first escaped mutation"`
+		TimeBudget  time.Duration `long:"time-budget" description:"Stop executing mutations after this duration, e.g. 2m, the report holds only the executed mutations"`
+		GoBinary    string        `long:"go-binary" description:"Go command of the built-in exec command, the test selection and the precompiled tests instead of the go command on the PATH, e.g. /usr/local/go1.22/bin/go"`
 	} `group:"Exec options"`
 
+	Mutate struct {
+		Out string `long:"out" description:"Directory into which the mutate command writes a patch for every mutation and an index.json" default:"patches"`
+	} `group:"Mutate command options"`
+
+	Report struct {
+		ExportMatrix  string   `long:"export-matrix" description:"Write a CSV matrix of which selected test killed which mutation into this file, needs --test-selection"`
+		Formats       []string `long:"report-format" description:"Write the report additionally in this format into its default file, e.g. report.md or badge.json for the shields.io badge of the mutation score (can be given multiple times)" choice:"badge" choice:"html" choice:"json" choice:"markdown" choice:"sarif"`
+		Outputs       []string `long:"output" description:"Write the report into this file instead of report.json, a format can be given as format=file, e.g. html=report.html, the formats are json, badge, html, markdown and sarif (can be given multiple times)"`
+		HistoryFile   string   `long:"history-file" description:"Append the mutation score and counts of the run with the time and the git commit to this JSON file, go-mutesting trend prints their changes"`
+		RunName       string   `long:"run-name" description:"Name of the run which is embedded in the report, e.g. nightly-2024-06-01"`
+		Labels        []string `long:"label" description:"Label of the run as key=value which is embedded in the report, e.g. team=payments (can be given multiple times)"`
+		MinMsi        float64  `long:"min-msi" description:"Exit with a non-zero exit code if the mutation score is below this minimum, e.g. 0.8"`
+		StrictNewCode string   `long:"strict-new-code" description:"Exit with a non-zero exit code if a mutation of code which changed compared to this git ref escapes, escaped mutations of other code only lower the mutation score, e.g. main"`
+		ProgressFile  string   `long:"progress-file" description:"Continuously write the progress of the run as JSON into this file, e.g. for CI systems and dashboards which poll it"`
+		Stream        string   `long:"stream" description:"Stream an event for every generated, executed and collected mutation while the run progresses, ndjson writes every event as a JSON object on its own line, test2json writes the events of go test -json with every mutation as a test named after its ID" choice:"ndjson" choice:"test2json"`
+		StreamFile    string   `long:"stream-file" description:"Write the events of --stream into this file instead of the standard output"`
+	} `group:"Report options"`
+
 	Test struct {
-		Recursive bool `long:"test-recursive" description:"Defines if the executer should test recursively"`
+		Recursive   bool   `long:"test-recursive" description:"Defines if the executer should test recursively"`
+		Precompile  bool   `long:"test-precompile" description:"Compile the tests of every mutation with go test -c and execute the test binary on its own, mutations which compile to the same test binary reuse the result and mutations which do not compile are skipped"`
+		Run         string `long:"test-run" description:"Execute only the tests which match this regex just like go test -run, the pattern is recorded for every mutation in the report"`
+		Selection   bool   `long:"test-selection" description:"Execute only the tests of the package which execute the changed lines of a mutation, mutations without such tests are not covered"`
+		GoTestFlags string `long:"gotest-flags" description:"Additional flags which are separated by spaces for the go test command of the built-in exec command, e.g. \"-race -count=1 -short\""`
 	} `group:"Test options"`
 
 	Remaining struct {
@@ -43,7 +98,31 @@ type Options struct {
 		SkipFileWithoutTest  bool     `yaml:"skip_without_test"`
 		SkipFileWithBuildTag bool     `yaml:"skip_with_build_tags"`
 		JSONOutput           bool     `yaml:"json_output"`
+		SarifOutput          bool     `yaml:"sarif_output"`
 		SilentMode           bool     `yaml:"silent_mode"`
 		ExcludeDirs          []string `yaml:"exclude_dirs"`
+		Exclude              []string `yaml:"exclude"`
+		UntestedEscaped      bool     `yaml:"untested_escaped"`
+		MinMsi               float64  `yaml:"min_msi"`
+		// GoBinary is the Go command which is used if --go-binary is not given
+		GoBinary string `yaml:"go_binary"`
+		// ExcludeNotCovered excludes the not covered mutants from the denominator of the mutation score
+		ExcludeNotCovered bool `yaml:"exclude_not_covered"`
+		// Packs are named groups of mutator names or suffix patterns which are enabled with --packs
+		Packs map[string][]string `yaml:"packs"`
+		// Plugins are mutators which are implemented by external executables
+		Plugins []Plugin `yaml:"plugins"`
+		// Mutators are the parameters of mutators by their names
+		Mutators map[string]map[string]interface{} `yaml:"mutators"`
 	}
 }
+
+// Plugin configures a mutator which is implemented by an external executable
+type Plugin struct {
+	// Name is the name of the mutator
+	Name string `yaml:"name"`
+	// Command is the executable with its arguments
+	Command []string `yaml:"command"`
+	// Nodes are the kinds of nodes which are sent to the executable, e.g. "CallExpr"
+	Nodes []string `yaml:"nodes"`
+}
@@ -1,5 +1,10 @@
 package models
 
+import (
+	"path/filepath"
+	"sort"
+)
+
 // ReportFileName File name for json report
 var ReportFileName string = "report.json"
 
@@ -10,6 +15,59 @@ type Report struct {
 	Timeouted []Mutant `json:"timeouted"`
 	Killed    []Mutant `json:"killed"`
 	Errored   []Mutant `json:"errored"`
+	// CaughtByVet are the mutants which were reported by go vet, so their tests were not executed.
+	CaughtByVet []Mutant `json:"caughtByVet,omitempty"`
+	// Duplicates are the mutants which are the same as another mutant and were therefore not executed.
+	Duplicates []Duplicate `json:"duplicates,omitempty"`
+	// NotCovered are the mutants which were not executed since no test covers them according to the coverage data or their package has no tests.
+	NotCovered []Mutant `json:"notCovered,omitempty"`
+	// ExcludeNotCovered is set if the not covered mutants are not part of the denominator of the mutation score.
+	ExcludeNotCovered bool `json:"excludeNotCovered,omitempty"`
+	// Stopped is the reason why the execution of mutations was stopped before all mutations were executed, it is empty for complete runs.
+	Stopped string `json:"stopped,omitempty"`
+	// GoBinary is the pinned Go command which executed the tests, it is empty if the go command on the PATH was used.
+	GoBinary string `json:"goBinary,omitempty"`
+	// GoVersion is the version of the pinned Go command, e.g. "go1.22.3".
+	GoVersion string `json:"goVersion,omitempty"`
+	// MinMsi is the minimum mutation score of --min-msi, it decides the color of the badge.
+	MinMsi float64 `json:"minMsi,omitempty"`
+	// StrictNewCode is the git ref of --strict-new-code, escaped mutants of code which changed compared to it are marked as new code.
+	StrictNewCode string `json:"strictNewCode,omitempty"`
+	// RunName is the name of the run of --run-name, e.g. "nightly-2024-06-01".
+	RunName string `json:"runName,omitempty"`
+	// Labels are the key value pairs of --label which tag the run, e.g. "team": "payments".
+	Labels map[string]string `json:"labels,omitempty"`
+
+	Files map[string]*Stats `json:"files,omitempty"`
+	// Packages are the stats of every mutated package by its import path.
+	Packages map[string]*Stats `json:"packages,omitempty"`
+	// Functions are the stats of every mutated function sorted by their mutation score, the weakest functions first.
+	Functions []*FunctionStats `json:"functions,omitempty"`
+	// UntestedPackages are the packages without test files whose mutations were not executed.
+	UntestedPackages []string `json:"untestedPackages,omitempty"`
+	// ExcludedFiles are the files which were not mutated since they are too large.
+	ExcludedFiles []ExcludedFile `json:"excludedFiles,omitempty"`
+}
+
+// Reasons of stopped runs
+const (
+	StoppedByFailFast   = "fail-fast"
+	StoppedByTimeBudget = "time-budget"
+)
+
+// Reasons of excluded files
+const (
+	ExcludedBySize    = "size"
+	ExcludedByMutants = "mutants"
+)
+
+// ExcludedFile file which was not mutated since it is too large
+type ExcludedFile struct {
+	File string `json:"file"`
+	// Reason is either ExcludedBySize or ExcludedByMutants
+	Reason  string `json:"reason"`
+	Size    int64  `json:"size,omitempty"`
+	Mutants int    `json:"mutants,omitempty"`
 }
 
 // Stats There is stats for mutations
@@ -21,6 +79,7 @@ type Stats struct {
 	ErrorCount           int64   `json:"errorCount"`
 	SkippedCount         int64   `json:"skippedCount"`
 	TimeOutCount         int64   `json:"timeOutCount"`
+	CaughtByVetCount     int64   `json:"caughtByVetCount"`
 	Msi                  float64 `json:"msi"`
 	MutationCodeCoverage int64   `json:"mutationCodeCoverage"`
 	CoveredCodeMsi       float64 `json:"coveredCodeMsi"`
@@ -32,35 +91,248 @@ type Mutant struct {
 	Mutator       Mutator `json:"mutator"`
 	Diff          string  `json:"diff"`
 	ProcessOutput string  `json:"processOutput,omitempty"`
+	// TestRestriction is the "go test -run" pattern of the executed tests if not all tests were executed, the verdict only holds for these tests
+	TestRestriction string `json:"testRestriction,omitempty"`
+	// NewCode is set for escaped mutants of code which changed compared to the git ref of --strict-new-code
+	NewCode bool `json:"newCode,omitempty"`
 }
 
 // Mutator mutator and changes in file
 type Mutator struct {
+	// ID identifies the mutant across runs, it is derived from the mutated file, the mutator and the position of the mutated code.
+	ID                 string `json:"id,omitempty"`
 	MutatorName        string `json:"mutatorName"`
 	OriginalSourceCode string `json:"originalSourceCode"`
 	MutatedSourceCode  string `json:"mutatedSourceCode"`
 	OriginalFilePath   string `json:"originalFilePath"`
 	OriginalStartLine  int64  `json:"originalStartLine"`
+	// OriginalStartColumn is the column of the mutated code in the original start line, counted in bytes starting at 1.
+	OriginalStartColumn int64 `json:"originalStartColumn,omitempty"`
+	// Function is the name of the mutated function, methods are prefixed with their receiver type, e.g. "T.m".
+	Function string `json:"function,omitempty"`
+}
+
+// FunctionStats stats for the mutations of one function
+type FunctionStats struct {
+	File     string `json:"file"`
+	Function string `json:"function"`
+	Stats
+}
+
+// MutantReference identifies a mutant by its checksum, mutator and position
+type MutantReference struct {
+	ID                  string `json:"id,omitempty"`
+	Checksum            string `json:"checksum"`
+	MutatorName         string `json:"mutatorName"`
+	OriginalFilePath    string `json:"originalFilePath"`
+	OriginalStartLine   int64  `json:"originalStartLine"`
+	OriginalStartColumn int64  `json:"originalStartColumn,omitempty"`
+}
+
+// Duplicate mutant with the same checksum as another mutant
+type Duplicate struct {
+	MutantReference
+	// Original is the first mutant with the same checksum, it is nil if the checksum is blacklisted.
+	Original *MutantReference `json:"original,omitempty"`
+}
+
+// HasLabels checks if the report is tagged with all of the given labels, e.g. to filter the reports of many runs.
+func (report *Report) HasLabels(labels map[string]string) bool {
+	for key, value := range labels {
+		if v, ok := report.Labels[key]; !ok || v != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// NewCodeEscaped returns the escaped mutants of new code.
+func (report *Report) NewCodeEscaped() []Mutant {
+	var mutants []Mutant
+	for _, mutant := range report.Escaped {
+		if mutant.NewCode {
+			mutants = append(mutants, mutant)
+		}
+	}
+
+	return mutants
 }
 
-// Calculate calculation for final report
+// File returns the stats of the given mutated file
+func (report *Report) File(path string) *Stats {
+	if report.Files == nil {
+		report.Files = map[string]*Stats{}
+	}
+
+	stats, ok := report.Files[path]
+	if !ok {
+		stats = &Stats{}
+		report.Files[path] = stats
+	}
+
+	return stats
+}
+
+// Function returns the stats of the given mutated function of the file.
+// Mutations of package level code have no function, so their stats are not recorded.
+func (report *Report) Function(path string, function string) *Stats {
+	if function == "" {
+		return &Stats{}
+	}
+
+	for _, stats := range report.Functions {
+		if stats.File == path && stats.Function == function {
+			return &stats.Stats
+		}
+	}
+
+	stats := &FunctionStats{
+		File:     path,
+		Function: function,
+	}
+	report.Functions = append(report.Functions, stats)
+
+	return &stats.Stats
+}
+
+// AggregatePackages sums up the stats of the mutated files by their packages, which maps the files to the import paths of their packages.
+// Files of unknown packages, e.g. of a baseline report, are aggregated by their directory instead.
+func (report *Report) AggregatePackages(packages map[string]string) {
+	report.Packages = map[string]*Stats{}
+
+	for file, stats := range report.Files {
+		name, ok := packages[file]
+		if !ok {
+			name = filepath.ToSlash(filepath.Dir(file))
+		}
+
+		pkg, ok := report.Packages[name]
+		if !ok {
+			pkg = &Stats{}
+			report.Packages[name] = pkg
+		}
+		pkg.add(stats)
+	}
+}
+
+// Calculate calculation for final report, the not covered mutants are excluded from the mutation score if ExcludeNotCovered is set
 func (report *Report) Calculate() {
-	report.Stats.Msi = report.MsiScore()
-	report.Stats.TotalMutantsCount = report.TotalCount()
+	report.Stats.calculate(report.ExcludeNotCovered)
+
+	for _, stats := range report.Files {
+		stats.calculate(report.ExcludeNotCovered)
+	}
+
+	for _, stats := range report.Packages {
+		stats.calculate(report.ExcludeNotCovered)
+	}
+
+	for _, stats := range report.Functions {
+		stats.calculate(report.ExcludeNotCovered)
+	}
+	sort.SliceStable(report.Functions, func(i, j int) bool {
+		a, b := report.Functions[i], report.Functions[j]
+		if a.Msi != b.Msi {
+			return a.Msi < b.Msi
+		} else if a.EscapedCount != b.EscapedCount {
+			return a.EscapedCount > b.EscapedCount
+		} else if a.File != b.File {
+			return a.File < b.File
+		}
+
+		return a.Function < b.Function
+	})
+}
+
+// WeakestFunctions returns at most limit functions with escaped mutations sorted by their mutation score, the report has to be calculated first.
+func (report *Report) WeakestFunctions(limit int) []*FunctionStats {
+	var weakest []*FunctionStats
+	for _, stats := range report.Functions {
+		if len(weakest) == limit {
+			break
+		}
+
+		if stats.EscapedCount > 0 {
+			weakest = append(weakest, stats)
+		}
+	}
+
+	return weakest
+}
+
+// CalculateCoverage calculation of the coverage stats for the final report
+func (report *Report) CalculateCoverage() {
+	report.Stats.CalculateCoverage()
+
+	for _, stats := range report.Files {
+		stats.CalculateCoverage()
+	}
+
+	for _, stats := range report.Packages {
+		stats.CalculateCoverage()
+	}
+
+	for _, stats := range report.Functions {
+		stats.CalculateCoverage()
+	}
 }
 
 // MsiScore msi score calculation
 func (report *Report) MsiScore() float64 {
-	total := report.TotalCount()
+	return report.Stats.msiScore(report.ExcludeNotCovered)
+}
+
+// TotalCount total mutations count
+func (report *Report) TotalCount() int64 {
+	return report.Stats.TotalCount()
+}
+
+// Calculate calculation for final stats
+func (stats *Stats) Calculate() {
+	stats.calculate(false)
+}
+
+func (stats *Stats) calculate(excludeNotCovered bool) {
+	stats.Msi = stats.msiScore(excludeNotCovered)
+	stats.TotalMutantsCount = stats.TotalCount()
+}
+
+// MsiScore msi score calculation
+func (stats *Stats) MsiScore() float64 {
+	return stats.msiScore(false)
+}
+
+func (stats *Stats) msiScore(excludeNotCovered bool) float64 {
+	total := stats.TotalCount()
+	if excludeNotCovered {
+		total -= stats.NotCoveredCount
+	}
 
 	if total == 0 {
 		return 0.0
 	}
 
-	return float64(report.Stats.KilledCount+report.Stats.ErrorCount+report.Stats.SkippedCount) / float64(total)
+	return float64(stats.KilledCount+stats.TimeOutCount+stats.ErrorCount+stats.SkippedCount+stats.CaughtByVetCount) / float64(total)
 }
 
 // TotalCount total mutations count
-func (report *Report) TotalCount() int64 {
-	return report.Stats.KilledCount + report.Stats.EscapedCount + report.Stats.ErrorCount + report.Stats.SkippedCount
+func (stats *Stats) TotalCount() int64 {
+	return stats.KilledCount + stats.TimeOutCount + stats.EscapedCount + stats.ErrorCount + stats.SkippedCount + stats.NotCoveredCount + stats.CaughtByVetCount
+}
+
+// CalculateCoverage calculation of the coverage stats which are only known if a coverage profile is used
+func (stats *Stats) CalculateCoverage() {
+	total := stats.TotalCount()
+	covered := total - stats.NotCoveredCount
+
+	stats.MutationCodeCoverage = 0
+	stats.CoveredCodeMsi = 0
+
+	if total != 0 {
+		stats.MutationCodeCoverage = covered * 100 / total
+	}
+	if covered != 0 {
+		stats.CoveredCodeMsi = float64(stats.KilledCount+stats.TimeOutCount+stats.ErrorCount+stats.SkippedCount+stats.CaughtByVetCount) / float64(covered)
+	}
 }
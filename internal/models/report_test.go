@@ -0,0 +1,142 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReportCalculate(t *testing.T) {
+	report := &Report{}
+	report.Stats = Stats{
+		KilledCount:     4,
+		EscapedCount:    2,
+		SkippedCount:    1,
+		ErrorCount:      1,
+		NotCoveredCount: 2,
+	}
+	report.File("a.go").KilledCount = 4
+	report.File("b.go").EscapedCount = 2
+
+	report.Calculate()
+
+	assert.Equal(t, int64(10), report.Stats.TotalMutantsCount)
+	assert.Equal(t, 0.6, report.Stats.Msi)
+	assert.Equal(t, int64(0), report.Stats.MutationCodeCoverage)
+	assert.Equal(t, 0.0, report.Stats.CoveredCodeMsi)
+	assert.Equal(t, 1.0, report.Files["a.go"].Msi)
+	assert.Equal(t, 0.0, report.Files["b.go"].Msi)
+
+	report.CalculateCoverage()
+
+	assert.Equal(t, int64(80), report.Stats.MutationCodeCoverage)
+	assert.Equal(t, 0.75, report.Stats.CoveredCodeMsi)
+	assert.Equal(t, int64(100), report.Files["a.go"].MutationCodeCoverage)
+	assert.Equal(t, 1.0, report.Files["a.go"].CoveredCodeMsi)
+}
+
+func TestReportCalculateExcludeNotCovered(t *testing.T) {
+	report := &Report{ExcludeNotCovered: true}
+	report.Stats = Stats{
+		KilledCount:     3,
+		EscapedCount:    1,
+		NotCoveredCount: 4,
+	}
+	report.File("a.go").NotCoveredCount = 4
+
+	report.Calculate()
+
+	assert.Equal(t, int64(8), report.Stats.TotalMutantsCount)
+	assert.Equal(t, 0.75, report.Stats.Msi)
+	assert.Equal(t, 0.75, report.MsiScore())
+	assert.Equal(t, 0.0, report.Files["a.go"].Msi)
+
+	report.ExcludeNotCovered = false
+	report.Calculate()
+
+	assert.Equal(t, 0.375, report.Stats.Msi)
+}
+
+func TestReportCalculateEmpty(t *testing.T) {
+	report := &Report{}
+
+	report.Calculate()
+	report.CalculateCoverage()
+
+	assert.Equal(t, Stats{}, report.Stats)
+}
+
+func TestReportCalculateTimeout(t *testing.T) {
+	report := &Report{}
+	report.Stats = Stats{
+		KilledCount:  2,
+		TimeOutCount: 1,
+		EscapedCount: 1,
+	}
+
+	report.Calculate()
+
+	assert.Equal(t, int64(4), report.Stats.TotalMutantsCount)
+	assert.Equal(t, 0.75, report.Stats.Msi)
+}
+
+func TestReportFunctions(t *testing.T) {
+	report := &Report{}
+	report.Function("a.go", "f").KilledCount = 3
+	report.Function("a.go", "f").EscapedCount = 1
+	report.Function("a.go", "T.m").EscapedCount = 2
+	report.Function("b.go", "f").KilledCount = 2
+	report.Function("b.go", "g").EscapedCount = 1
+	report.Function("b.go", "").EscapedCount = 5
+
+	report.Calculate()
+
+	var names []string
+	for _, stats := range report.Functions {
+		names = append(names, stats.File+":"+stats.Function)
+	}
+	assert.Equal(t, []string{"a.go:T.m", "b.go:g", "a.go:f", "b.go:f"}, names)
+	assert.Equal(t, 0.75, report.Functions[2].Msi)
+	assert.Equal(t, int64(4), report.Functions[2].TotalMutantsCount)
+
+	assert.Equal(t, report.Functions[:3], report.WeakestFunctions(10))
+	assert.Equal(t, report.Functions[:2], report.WeakestFunctions(2))
+}
+
+func TestReportHasLabels(t *testing.T) {
+	report := &Report{
+		Labels: map[string]string{
+			"team": "payments",
+			"env":  "nightly",
+		},
+	}
+
+	assert.True(t, report.HasLabels(nil))
+	assert.True(t, report.HasLabels(map[string]string{"team": "payments"}))
+	assert.True(t, report.HasLabels(map[string]string{"team": "payments", "env": "nightly"}))
+	assert.False(t, report.HasLabels(map[string]string{"team": "search"}))
+	assert.False(t, report.HasLabels(map[string]string{"region": "eu"}))
+	assert.False(t, (&Report{}).HasLabels(map[string]string{"team": "payments"}))
+}
+
+func TestReportAggregatePackages(t *testing.T) {
+	report := &Report{}
+	report.File("example/a.go").KilledCount = 3
+	report.File("example/a.go").NotCoveredCount = 1
+	report.File("example/b.go").EscapedCount = 1
+	report.File("example/sub/sub.go").KilledCount = 2
+	report.File("other/c.go").EscapedCount = 2
+
+	report.AggregatePackages(map[string]string{
+		"example/a.go":       "example.com/example",
+		"example/b.go":       "example.com/example",
+		"example/sub/sub.go": "example.com/example/sub",
+	})
+	report.Calculate()
+
+	assert.Equal(t, map[string]*Stats{
+		"example.com/example":     {KilledCount: 3, NotCoveredCount: 1, EscapedCount: 1, TotalMutantsCount: 5, Msi: 0.6},
+		"example.com/example/sub": {KilledCount: 2, TotalMutantsCount: 2, Msi: 1},
+		"other":                   {EscapedCount: 2, TotalMutantsCount: 2},
+	}, report.Packages)
+}
@@ -0,0 +1,147 @@
+package models
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+)
+
+// SarifReportFileName File name for sarif report
+var SarifReportFileName string = "report.sarif"
+
+const (
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion = "2.1.0"
+	sarifTool    = "go-mutesting"
+	sarifToolURI = "https://github.com/VirtualRoyalty/go-mutesting"
+)
+
+// SarifReport Structure for SARIF report of escaped mutants
+type SarifReport struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []SarifRun `json:"runs"`
+}
+
+// SarifRun one run of the mutation testing tool
+type SarifRun struct {
+	Tool    SarifTool     `json:"tool"`
+	Results []SarifResult `json:"results"`
+}
+
+// SarifTool tool which produced the results
+type SarifTool struct {
+	Driver SarifDriver `json:"driver"`
+}
+
+// SarifDriver description of the tool and its rules
+type SarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []SarifRule `json:"rules"`
+}
+
+// SarifRule rule which is a mutator
+type SarifRule struct {
+	ID               string       `json:"id"`
+	ShortDescription SarifMessage `json:"shortDescription"`
+}
+
+// SarifResult result for one escaped mutant
+type SarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   SarifMessage    `json:"message"`
+	Locations []SarifLocation `json:"locations"`
+}
+
+// SarifMessage text message
+type SarifMessage struct {
+	Text string `json:"text"`
+}
+
+// SarifLocation location of a result
+type SarifLocation struct {
+	PhysicalLocation SarifPhysicalLocation `json:"physicalLocation"`
+}
+
+// SarifPhysicalLocation file and region of a result
+type SarifPhysicalLocation struct {
+	ArtifactLocation SarifArtifactLocation `json:"artifactLocation"`
+	Region           *SarifRegion          `json:"region,omitempty"`
+}
+
+// SarifArtifactLocation file of a result
+type SarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+// SarifRegion line and column of a result
+type SarifRegion struct {
+	StartLine   int64 `json:"startLine"`
+	StartColumn int64 `json:"startColumn,omitempty"`
+}
+
+// Sarif converts escaped mutants of the report into a SARIF report
+func (report *Report) Sarif() *SarifReport {
+	rules := map[string]struct{}{}
+	results := make([]SarifResult, 0, len(report.Escaped))
+
+	for _, mutant := range report.Escaped {
+		name := mutant.Mutator.MutatorName
+		rules[name] = struct{}{}
+
+		location := SarifLocation{
+			PhysicalLocation: SarifPhysicalLocation{
+				ArtifactLocation: SarifArtifactLocation{
+					URI: filepath.ToSlash(mutant.Mutator.OriginalFilePath),
+				},
+			},
+		}
+		// SARIF lines start at 1, the fallback line 0 means that the line is unknown
+		if mutant.Mutator.OriginalStartLine > 0 {
+			location.PhysicalLocation.Region = &SarifRegion{
+				StartLine:   mutant.Mutator.OriginalStartLine,
+				StartColumn: mutant.Mutator.OriginalStartColumn,
+			}
+		}
+
+		results = append(results, SarifResult{
+			RuleID: name,
+			Level:  "warning",
+			Message: SarifMessage{
+				Text: fmt.Sprintf("Mutant of %q escaped: tests passed with the mutated code\n%s", name, mutant.Diff),
+			},
+			Locations: []SarifLocation{location},
+		})
+	}
+
+	names := make([]string, 0, len(rules))
+	for name := range rules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	driver := SarifDriver{
+		Name:           sarifTool,
+		InformationURI: sarifToolURI,
+		Rules:          make([]SarifRule, 0, len(names)),
+	}
+	for _, name := range names {
+		driver.Rules = append(driver.Rules, SarifRule{
+			ID:               name,
+			ShortDescription: SarifMessage{Text: fmt.Sprintf("Mutator %s", name)},
+		})
+	}
+
+	return &SarifReport{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs: []SarifRun{
+			{
+				Tool:    SarifTool{Driver: driver},
+				Results: results,
+			},
+		},
+	}
+}
@@ -0,0 +1,76 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReportSarif(t *testing.T) {
+	report := &Report{
+		Escaped: []Mutant{
+			{
+				Mutator: Mutator{
+					MutatorName:         "statement/remove",
+					OriginalFilePath:    "example/example.go",
+					OriginalStartLine:   12,
+					OriginalStartColumn: 2,
+				},
+				Diff: "@@ -9,7 +9,7 @@",
+			},
+			{
+				Mutator: Mutator{
+					MutatorName:      "branch/if",
+					OriginalFilePath: "example/example.go",
+				},
+			},
+			{
+				Mutator: Mutator{
+					MutatorName:       "statement/remove",
+					OriginalFilePath:  "example/sub/sub.go",
+					OriginalStartLine: 3,
+				},
+			},
+		},
+		Killed: []Mutant{
+			{
+				Mutator: Mutator{
+					MutatorName: "numbers/incrementer",
+				},
+			},
+		},
+	}
+
+	sarif := report.Sarif()
+
+	assert.Equal(t, "2.1.0", sarif.Version)
+	assert.Len(t, sarif.Runs, 1)
+
+	run := sarif.Runs[0]
+	assert.Equal(t, "go-mutesting", run.Tool.Driver.Name)
+	assert.Equal(t, []SarifRule{
+		{ID: "branch/if", ShortDescription: SarifMessage{Text: "Mutator branch/if"}},
+		{ID: "statement/remove", ShortDescription: SarifMessage{Text: "Mutator statement/remove"}},
+	}, run.Tool.Driver.Rules)
+
+	assert.Len(t, run.Results, 3)
+
+	assert.Equal(t, "statement/remove", run.Results[0].RuleID)
+	assert.Equal(t, "example/example.go", run.Results[0].Locations[0].PhysicalLocation.ArtifactLocation.URI)
+	assert.Equal(t, &SarifRegion{StartLine: 12, StartColumn: 2}, run.Results[0].Locations[0].PhysicalLocation.Region)
+
+	assert.Equal(t, "branch/if", run.Results[1].RuleID)
+	assert.Nil(t, run.Results[1].Locations[0].PhysicalLocation.Region)
+
+	assert.Equal(t, "example/sub/sub.go", run.Results[2].Locations[0].PhysicalLocation.ArtifactLocation.URI)
+	assert.Equal(t, &SarifRegion{StartLine: 3}, run.Results[2].Locations[0].PhysicalLocation.Region)
+}
+
+func TestReportSarifEmpty(t *testing.T) {
+	sarif := (&Report{}).Sarif()
+
+	assert.Len(t, sarif.Runs, 1)
+	assert.NotNil(t, sarif.Runs[0].Results)
+	assert.Empty(t, sarif.Runs[0].Results)
+	assert.Empty(t, sarif.Runs[0].Tool.Driver.Rules)
+}
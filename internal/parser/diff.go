@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"regexp"
 	"strconv"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
 )
 
 const (
@@ -54,3 +57,87 @@ func FindOriginalStartLine(diff []byte) int64 {
 
 	return changedLines[0]
 }
+
+// ChangedLines returns the first and last line of the original source which differ from the mutated source.
+// Lines which are only inserted by the mutation are assigned to the line of the original source in front of which they are inserted.
+// If the sources do not differ, both lines are 0.
+func ChangedLines(original []byte, mutated []byte) (int64, int64) {
+	matcher := difflib.NewMatcher(difflib.SplitLines(string(original)), difflib.SplitLines(string(mutated)))
+
+	first, last := fallbackLine, fallbackLine
+	for _, op := range matcher.GetOpCodes() {
+		if op.Tag == 'e' {
+			continue
+		}
+
+		start, end := int64(op.I1+1), int64(op.I2)
+		if end < start {
+			end = start
+		}
+
+		if first == fallbackLine || start < first {
+			first = start
+		}
+		if end > last {
+			last = end
+		}
+	}
+
+	return first, last
+}
+
+// FirstChange returns the first line of the original source and the first line of the mutated source which differ without surrounding white space.
+// The original line is empty if the mutation only inserts lines and the mutated line is empty if it only removes lines.
+// If the sources do not differ, both lines are empty.
+func FirstChange(original []byte, mutated []byte) (string, string) {
+	originalLines, mutatedLines := difflib.SplitLines(string(original)), difflib.SplitLines(string(mutated))
+	matcher := difflib.NewMatcher(originalLines, mutatedLines)
+
+	for _, op := range matcher.GetOpCodes() {
+		if op.Tag == 'e' {
+			continue
+		}
+
+		var originalLine, mutatedLine string
+		if op.I1 < op.I2 {
+			originalLine = strings.TrimSpace(originalLines[op.I1])
+		}
+		if op.J1 < op.J2 {
+			mutatedLine = strings.TrimSpace(mutatedLines[op.J1])
+		}
+
+		return originalLine, mutatedLine
+	}
+
+	return "", ""
+}
+
+// UnifiedDiff returns the unified diff (-u) of the original and the mutated source with the given file labels just like "diff -u".
+// The diff is empty if the sources do not differ.
+func UnifiedDiff(original []byte, mutated []byte, fromFile string, toFile string) ([]byte, error) {
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(string(original)),
+		B:        splitLines(string(mutated)),
+		FromFile: fromFile,
+		ToFile:   toFile,
+		Context:  diffContextLines,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(diff), nil
+}
+
+// splitLines splits the source into lines which keep their newline.
+// Unlike difflib.SplitLines no empty line is added after the final newline, since patches with such a line do not apply.
+func splitLines(s string) []string {
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		return lines[:len(lines)-1]
+	}
+
+	lines[len(lines)-1] += "\n"
+
+	return lines
+}
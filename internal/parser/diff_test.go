@@ -85,3 +85,130 @@ func TestParseDiffOutput(t *testing.T) {
 		})
 	}
 }
+
+func TestChangedLines(t *testing.T) {
+	original := "package main\n\nfunc main() {\n\ta := 1\n\tb := 2\n\tfmt.Println(a, b)\n}\n"
+
+	tests := []struct {
+		name          string
+		mutated       string
+		expectedFirst int64
+		expectedLast  int64
+	}{
+		{
+			name:          "changed line",
+			mutated:       "package main\n\nfunc main() {\n\ta := 2\n\tb := 2\n\tfmt.Println(a, b)\n}\n",
+			expectedFirst: 4,
+			expectedLast:  4,
+		},
+		{
+			name:          "removed line",
+			mutated:       "package main\n\nfunc main() {\n\ta := 1\n\tb := 2\n}\n",
+			expectedFirst: 6,
+			expectedLast:  6,
+		},
+		{
+			name:          "inserted line",
+			mutated:       "package main\n\nfunc main() {\n\ta := 1\n\tb := 2\n\t_ = b\n\tfmt.Println(a, b)\n}\n",
+			expectedFirst: 6,
+			expectedLast:  6,
+		},
+		{
+			name:          "multiple changes",
+			mutated:       "package main\n\nfunc main() {\n\ta := 2\n\tb := 2\n\tfmt.Println(b, a)\n}\n",
+			expectedFirst: 4,
+			expectedLast:  6,
+		},
+		{
+			name:          "no changes",
+			mutated:       original,
+			expectedFirst: 0,
+			expectedLast:  0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			first, last := ChangedLines([]byte(original), []byte(tt.mutated))
+			if first != tt.expectedFirst || last != tt.expectedLast {
+				t.Errorf("ChangedLines() = %v, %v, want %v, %v", first, last, tt.expectedFirst, tt.expectedLast)
+			}
+		})
+	}
+}
+
+func TestFirstChange(t *testing.T) {
+	original := "package main\n\nfunc main() {\n\ta := 1\n\tb := 2\n\tfmt.Println(a, b)\n}\n"
+
+	tests := []struct {
+		name             string
+		mutated          string
+		expectedOriginal string
+		expectedMutated  string
+	}{
+		{
+			name:             "changed line",
+			mutated:          "package main\n\nfunc main() {\n\ta := 2\n\tb := 2\n\tfmt.Println(a, b)\n}\n",
+			expectedOriginal: "a := 1",
+			expectedMutated:  "a := 2",
+		},
+		{
+			name:             "removed line",
+			mutated:          "package main\n\nfunc main() {\n\ta := 1\n\tb := 2\n}\n",
+			expectedOriginal: "fmt.Println(a, b)",
+		},
+		{
+			name:            "inserted line",
+			mutated:         "package main\n\nfunc main() {\n\ta := 1\n\tb := 2\n\t_ = b\n\tfmt.Println(a, b)\n}\n",
+			expectedMutated: "_ = b",
+		},
+		{
+			name:    "no changes",
+			mutated: original,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			originalLine, mutatedLine := FirstChange([]byte(original), []byte(tt.mutated))
+			if originalLine != tt.expectedOriginal || mutatedLine != tt.expectedMutated {
+				t.Errorf("FirstChange() = %q, %q, want %q, %q", originalLine, mutatedLine, tt.expectedOriginal, tt.expectedMutated)
+			}
+		})
+	}
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	original := []byte("package a\n\nfunc a() int {\n\tn := 1\n\tn++\n\n\treturn n\n}\n")
+	mutated := []byte("package a\n\nfunc a() int {\n\tn := 1\n\n\treturn n\n}\n")
+
+	diff, err := UnifiedDiff(original, mutated, "Original", "New")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "--- Original\n+++ New\n@@ -2,7 +2,6 @@\n \n func a() int {\n \tn := 1\n-\tn++\n \n \treturn n\n }\n"
+	if string(diff) != expected {
+		t.Errorf("UnifiedDiff() = %q, want %q", diff, expected)
+	}
+	if line := FindOriginalStartLine(diff); line != 5 {
+		t.Errorf("FindOriginalStartLine() = %v, want 5", line)
+	}
+
+	// Changes at the end of the file have no context after the final newline
+	diff, err = UnifiedDiff(original, []byte("package a\n\nfunc a() int {\n\tn := 1\n\tn++\n\n\treturn 0\n}\n"), "Original", "New")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = "--- Original\n+++ New\n@@ -4,5 +4,5 @@\n \tn := 1\n \tn++\n \n-\treturn n\n+\treturn 0\n }\n"
+	if string(diff) != expected {
+		t.Errorf("UnifiedDiff() = %q, want %q", diff, expected)
+	}
+
+	diff, err = UnifiedDiff(original, original, "Original", "New")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(diff) != 0 {
+		t.Errorf("UnifiedDiff() = %q, want an empty diff", diff)
+	}
+}
@@ -3,14 +3,15 @@ package parser
 import (
 	"fmt"
 	"go/ast"
-	"go/build"
+	"go/importer"
 	"go/parser"
 	"go/token"
 	"go/types"
+	"io"
 	"os"
 	"path/filepath"
 
-	"golang.org/x/tools/go/loader" //nolint:staticcheck
+	"golang.org/x/tools/go/packages"
 
 	"github.com/VirtualRoyalty/go-mutesting/internal/filter"
 )
@@ -40,52 +41,124 @@ func ParseSource(data interface{}) (*ast.File, *token.FileSet, error) {
 }
 
 // ParseAndTypeCheckFile parses and type-checks the given file, and returns everything interesting about the file.
+// The package of the file is resolved by the go tool, so modules, vendoring, build constraints and cgo are handled the same way as by "go build".
+// Files inside of "testdata" directories, which the go tool ignores, are type-checked on their own.
+// The build tags are a comma separated list just like for "go build -tags".
 // If a fatal error is encountered the error return argument is not nil.
-func ParseAndTypeCheckFile(file string, collectors []filter.NodeCollector) (*ast.File, *token.FileSet, *types.Package, *types.Info, error) {
+func ParseAndTypeCheckFile(file string, tags string, collectors []filter.NodeCollector) (*ast.File, *token.FileSet, *types.Package, *types.Info, error) {
 	fileAbs, err := filepath.Abs(file)
 	if err != nil {
 		return nil, nil, nil, nil, fmt.Errorf("Could not absolute the file path of %q: %v", file, err)
 	}
-	dir := filepath.Dir(fileAbs)
 
-	buildPkg, err := build.ImportDir(dir, build.FindOnly)
-	if err != nil {
-		return nil, nil, nil, nil, fmt.Errorf("Could not create build package of %q: %v", file, err)
+	pattern := "file=" + fileAbs
+	if inTestdata(fileAbs) {
+		// Every file inside of "testdata" directories is its own program, e.g. the test cases of the mutators
+		pattern = fileAbs
 	}
 
-	var conf = loader.Config{
-		ParserMode: parser.AllErrors | parser.ParseComments,
+	pkgs, err := packages.Load(&packages.Config{
+		Mode:       packages.NeedName | packages.NeedFiles | packages.NeedCompiledGoFiles | packages.NeedImports | packages.NeedExportFile,
+		Dir:        filepath.Dir(fileAbs),
+		BuildFlags: []string{"-tags", tags},
+	}, pattern)
+	if err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("Could not load package of file %q: %v", file, err)
 	}
 
-	if buildPkg.ImportPath != "." {
-		conf.Import(buildPkg.ImportPath)
-	} else {
-		// This is most definitely the case for files inside a "testdata" package
-		conf.CreateFromFilenames(dir, fileAbs)
+	for _, pkg := range pkgs {
+		// Files which use cgo are only part of the GoFiles since the go tool compiles their translations instead
+		for _, f := range pkg.GoFiles {
+			if f == fileAbs {
+				return typeCheckPackage(pkg, fileAbs, collectors)
+			}
+		}
 	}
 
-	conf.AllowErrors = true
-	prog, err := conf.Load()
-	if err != nil {
-		return nil, nil, nil, nil, fmt.Errorf("Could not load package of file %q: %v", file, err)
-	}
+	return nil, nil, nil, nil, fmt.Errorf("Could not load package of file %q: the file is not part of any package", file)
+}
 
-	pkgInfo := prog.InitialPackages()[0]
+// typeCheckPackage parses and type-checks the given package, and returns everything interesting about the given file of the package.
+// Imported packages are read from their export data. Type errors are ignored since the file can still be mutated.
+// If the given file uses cgo, it is type-checked in place of its translation with a fake "C" package.
+func typeCheckPackage(pkg *packages.Package, fileAbs string, collectors []filter.NodeCollector) (*ast.File, *token.FileSet, *types.Package, *types.Info, error) {
+	fset := token.NewFileSet()
 
 	var src *ast.File
-	for _, f := range pkgInfo.Files {
-		if prog.Fset.Position(f.Pos()).Filename == fileAbs {
-			src = f
+	cgo := false
+	files := make([]*ast.File, 0, len(pkg.CompiledGoFiles))
+	for _, f := range pkg.CompiledGoFiles {
+		// Syntax errors are ignored as long as there is a partial AST just like type errors
+		parsed, err := parser.ParseFile(fset, f, nil, parser.AllErrors|parser.ParseComments)
+		if parsed == nil {
+			return nil, nil, nil, nil, fmt.Errorf("Could not parse file %q: %v", f, err)
+		}
+
+		// The translation of cgo refers to its original file with a line directive
+		if f != fileAbs && fset.Position(parsed.Package).Filename == fileAbs {
+			parsed, err = parser.ParseFile(fset, fileAbs, nil, parser.AllErrors|parser.ParseComments)
+			if parsed == nil {
+				return nil, nil, nil, nil, fmt.Errorf("Could not parse file %q: %v", fileAbs, err)
+			}
 
-			break
+			f = fileAbs
+			cgo = true
 		}
-	}
 
-	if src != nil {
-		for _, c := range collectors {
-			c.Collect(src, prog.Fset, fileAbs)
+		if f == fileAbs {
+			src = parsed
 		}
+		files = append(files, parsed)
+	}
+	if src == nil {
+		return nil, nil, nil, nil, fmt.Errorf("Could not find the compiled file of %q in its package", fileAbs)
 	}
 
-	return src, prog.Fset, pkgInfo.Pkg, &pkgInfo.Info, nil
+	conf := types.Config{
+		Importer: importer.ForCompiler(fset, "gc", func(path string) (io.ReadCloser, error) {
+			imported, ok := pkg.Imports[path]
+			if !ok || imported.ExportFile == "" {
+				return nil, fmt.Errorf("no export data for %q", path)
+			}
+
+			return os.Open(imported.ExportFile)
+		}),
+		Error:       func(err error) {},
+		FakeImportC: cgo,
+	}
+
+	info := &types.Info{
+		Types:      map[ast.Expr]types.TypeAndValue{},
+		Instances:  map[*ast.Ident]types.Instance{},
+		Defs:       map[*ast.Ident]types.Object{},
+		Uses:       map[*ast.Ident]types.Object{},
+		Implicits:  map[ast.Node]types.Object{},
+		Selections: map[*ast.SelectorExpr]*types.Selection{},
+		Scopes:     map[ast.Node]*types.Scope{},
+	}
+
+	typesPkg, _ := conf.Check(pkg.PkgPath, fset, files, info)
+
+	for _, c := range collectors {
+		c.Collect(src, fset, fileAbs)
+	}
+
+	return src, fset, typesPkg, info, nil
+}
+
+// inTestdata reports whether the file is inside of a "testdata" directory of its module.
+// Modules inside of "testdata" directories are resolved by the go tool just like any other module.
+func inTestdata(file string) bool {
+	for dir := filepath.Dir(file); ; dir = filepath.Dir(dir) {
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			return false
+		}
+		if filepath.Base(dir) == "testdata" {
+			return true
+		}
+
+		if parent := filepath.Dir(dir); parent == dir {
+			return false
+		}
+	}
 }
@@ -1,6 +1,7 @@
 package parser
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -17,6 +18,47 @@ func TestParseAndTypeCheckFileTypeCheckWholePackage(t *testing.T) {
 		annotationProcessor,
 		skipFilterProcessor,
 	}
-	_, _, _, _, err := ParseAndTypeCheckFile("../../astutil/create.go", collectors)
+	src, _, pkg, info, err := ParseAndTypeCheckFile("../../astutil/create.go", "", collectors)
 	assert.Nil(t, err)
+	assert.NotNil(t, src)
+
+	assert.Equal(t, "github.com/VirtualRoyalty/go-mutesting/astutil", pkg.Path())
+	// Functions of other files of the package are known as well
+	assert.NotNil(t, pkg.Scope().Lookup("IdentifiersInStatement"))
+
+	var uses int
+	for ident, obj := range info.Uses {
+		if ident.Name == "IdentifiersInStatement" && obj.Pkg() == pkg {
+			uses++
+		}
+	}
+	assert.Equal(t, 1, uses)
+}
+
+func TestParseAndTypeCheckFileWithTags(t *testing.T) {
+	_, _, _, _, err := ParseAndTypeCheckFile("../importing/filepathfixtures/tagged.go", "", nil)
+	assert.NotNil(t, err)
+
+	src, _, pkg, _, err := ParseAndTypeCheckFile("../importing/filepathfixtures/tagged.go", "mutestingtag", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, src)
+	assert.NotNil(t, pkg.Scope().Lookup("tagged"))
+}
+
+func TestParseAndTypeCheckFileWithCgo(t *testing.T) {
+	src, fset, pkg, info, err := ParseAndTypeCheckFile("../../testdata/cgo/cgo.go", "", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, src)
+
+	// The original file is mutated instead of its translation by cgo
+	assert.Equal(t, "cgo.go", filepath.Base(fset.Position(src.Pos()).Filename))
+	assert.NotNil(t, pkg.Scope().Lookup("random"))
+
+	var uses int
+	for ident, obj := range info.Uses {
+		if ident.Name == "random" && obj.Pkg() == pkg {
+			uses++
+		}
+	}
+	assert.Equal(t, 1, uses)
 }
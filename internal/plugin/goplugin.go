@@ -0,0 +1,65 @@
+package plugin
+
+import (
+	"fmt"
+	goplugin "plugin"
+	"sort"
+	"sync"
+
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+// MutatorsSymbol is the name of the function which a Go plugin has to export, it must be of the type "func() map[string]mutator.Mutator".
+const MutatorsSymbol = "Mutators"
+
+var (
+	goPluginsMutex sync.Mutex
+	// goPlugins maps the paths of the loaded Go plugins to the names of their mutators, since a Go plugin can only be loaded once
+	goPlugins = map[string][]string{}
+)
+
+// LoadGo loads the Go plugin at the given path and registers its mutators, it returns their names.
+// Go plugins are only supported on Linux, FreeBSD and macOS with cgo and have to be built with the same versions of Go and
+// go-mutesting as the executable which loads them. Loading the same plugin again returns the names of its mutators without registering them again.
+func LoadGo(path string) ([]string, error) {
+	goPluginsMutex.Lock()
+	defer goPluginsMutex.Unlock()
+
+	if names, ok := goPlugins[path]; ok {
+		return names, nil
+	}
+
+	p, err := goplugin.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	symbol, err := p.Lookup(MutatorsSymbol)
+	if err != nil {
+		return nil, err
+	}
+	mutators, ok := symbol.(func() map[string]mutator.Mutator)
+	if !ok {
+		return nil, fmt.Errorf("%s of plugin %q is a %T instead of a func() map[string]mutator.Mutator", MutatorsSymbol, path, symbol)
+	}
+
+	registered := mutators()
+	names := make([]string, 0, len(registered))
+	for name, m := range registered {
+		if _, err := mutator.New(name); err == nil {
+			return nil, fmt.Errorf("the mutator %q of plugin %q already exists", name, path)
+		} else if m == nil {
+			return nil, fmt.Errorf("the mutator %q of plugin %q is nil", name, path)
+		}
+
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		mutator.Register(name, registered[name])
+	}
+	goPlugins[path] = names
+
+	return names, nil
+}
@@ -0,0 +1,38 @@
+package plugin
+
+import (
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+func TestLoadGo(t *testing.T) {
+	if runtime.GOOS != "linux" && runtime.GOOS != "darwin" {
+		t.Skip("Go plugins are not supported on " + runtime.GOOS)
+	}
+
+	library := filepath.Join(t.TempDir(), "goplugin.so")
+	build := exec.Command("go", "build", "-buildmode=plugin", "-o", library, "../../testdata/goplugin")
+	output, err := build.CombinedOutput()
+	if err != nil {
+		t.Skipf("Could not build Go plugin: %s", output)
+	}
+
+	names, err := LoadGo(library)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"goplugin/return"}, names)
+	assert.Contains(t, mutator.List(), "goplugin/return")
+
+	// Loading the plugin again does not register its mutators again
+	names, err = LoadGo(library)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"goplugin/return"}, names)
+
+	_, err = LoadGo(filepath.Join(t.TempDir(), "unknown.so"))
+	assert.Error(t, err)
+}
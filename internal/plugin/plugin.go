@@ -0,0 +1,301 @@
+package plugin
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"go/types"
+	"io"
+	"os"
+	"os/exec"
+	"reflect"
+	"sync"
+
+	"github.com/VirtualRoyalty/go-mutesting/internal/models"
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+// Request is written as a single line of JSON to the standard input of a plugin for every node of its kinds.
+type Request struct {
+	// Kind is the name of the type of the node in the go/ast package, e.g. "CallExpr"
+	Kind string `json:"kind"`
+	// Source is the Go source code of the node
+	Source string `json:"source"`
+	// Type is the type of an expression, e.g. "*example.com/dsl.Builder"
+	Type string `json:"type,omitempty"`
+	// Package is the import path of the mutated package
+	Package string `json:"package,omitempty"`
+}
+
+// Response is read as a single line of JSON from the standard output of a plugin for every request.
+type Response struct {
+	// Mutations are the Go source code of every mutation of the node, each must be a node of the same kind
+	Mutations []string `json:"mutations"`
+	// Error stops the run with this error message
+	Error string `json:"error,omitempty"`
+}
+
+// Plugin is a mutator which is implemented by an external executable that speaks JSON over its standard input and output.
+// The executable is started with the first request and is kept running until the plugin is closed.
+type Plugin struct {
+	name    string
+	command []string
+	kinds   map[string]bool
+
+	mutex  sync.Mutex
+	cmd    *exec.Cmd
+	stdin  io.WriteCloser
+	stdout *bufio.Reader
+	err    error
+}
+
+// New returns the plugin of the configuration.
+func New(config models.Plugin) (*Plugin, error) {
+	if config.Name == "" {
+		return nil, fmt.Errorf("plugin with the command %q has no name", config.Command)
+	} else if len(config.Command) == 0 {
+		return nil, fmt.Errorf("plugin %q has no command", config.Name)
+	} else if len(config.Nodes) == 0 {
+		return nil, fmt.Errorf("plugin %q has no node kinds", config.Name)
+	}
+
+	p := &Plugin{
+		name:    config.Name,
+		command: config.Command,
+		kinds:   map[string]bool{},
+	}
+	for _, kind := range config.Nodes {
+		p.kinds[kind] = true
+	}
+
+	return p, nil
+}
+
+// Name returns the name of the mutator of the plugin.
+func (p *Plugin) Name() string {
+	return p.name
+}
+
+// Err returns the first error of the plugin, the mutator of the plugin does not mutate anymore after an error.
+func (p *Plugin) Err() error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	return p.err
+}
+
+// Mutator returns the mutator which requests the mutations of every node of the kinds of the plugin.
+func (p *Plugin) Mutator() mutator.Mutator {
+	return func(pkg *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+		kind := reflect.TypeOf(node).Elem().Name()
+		if !p.kinds[kind] {
+			return nil
+		}
+
+		var source bytes.Buffer
+		err := printer.Fprint(&source, token.NewFileSet(), node)
+		if err != nil {
+			p.fail(err)
+
+			return nil
+		}
+
+		request := Request{
+			Kind:   kind,
+			Source: source.String(),
+		}
+		if expr, ok := node.(ast.Expr); ok && info != nil {
+			if t := info.TypeOf(expr); t != nil {
+				request.Type = t.String()
+			}
+		}
+		if pkg != nil {
+			request.Package = pkg.Path()
+		}
+
+		response, err := p.request(request)
+		if err != nil {
+			p.fail(err)
+
+			return nil
+		}
+
+		var mutations []mutator.Mutation
+		for _, src := range response.Mutations {
+			replacement, err := parseNode(node, src)
+			if err != nil {
+				p.fail(fmt.Errorf("mutation %q of %q is not valid: %v", src, request.Source, err))
+
+				return nil
+			}
+
+			mutations = append(mutations, replace(node, replacement))
+		}
+
+		return mutations
+	}
+}
+
+// Close stops the executable of the plugin.
+func (p *Plugin) Close() error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.cmd == nil {
+		return nil
+	}
+
+	_ = p.stdin.Close()
+	err := p.cmd.Wait()
+	p.cmd = nil
+
+	return err
+}
+
+func (p *Plugin) fail(err error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.err == nil {
+		p.err = err
+	}
+}
+
+func (p *Plugin) request(request Request) (*Response, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.err != nil {
+		return &Response{}, nil
+	}
+
+	if p.cmd == nil {
+		err := p.start()
+		if err != nil {
+			return nil, fmt.Errorf("could not start %q: %v", p.command, err)
+		}
+	}
+
+	content, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = p.stdin.Write(append(content, '\n'))
+	if err != nil {
+		return nil, fmt.Errorf("could not write request: %v", err)
+	}
+
+	line, err := p.stdout.ReadBytes('\n')
+	if err != nil {
+		return nil, fmt.Errorf("could not read response: %v", err)
+	}
+
+	response := &Response{}
+	err = json.Unmarshal(line, response)
+	if err != nil {
+		return nil, fmt.Errorf("could not read response %q: %v", line, err)
+	} else if response.Error != "" {
+		return nil, fmt.Errorf("%s", response.Error)
+	}
+
+	return response, nil
+}
+
+func (p *Plugin) start() error {
+	cmd := exec.Command(p.command[0], p.command[1:]...)
+	cmd.Stderr = os.Stderr
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.Start()
+	if err != nil {
+		return err
+	}
+
+	p.cmd = cmd
+	p.stdin = stdin
+	p.stdout = bufio.NewReader(stdout)
+
+	return nil
+}
+
+// parseNode parses the source code of a mutation of the node, which must be of the same kind as the node.
+func parseNode(node ast.Node, src string) (ast.Node, error) {
+	var parsed ast.Node
+	if _, ok := node.(ast.Stmt); ok {
+		f, err := parser.ParseFile(token.NewFileSet(), "", "package mutation\nfunc _() {\n"+src+"\n}", 0)
+		if err != nil {
+			return nil, err
+		}
+
+		body := f.Decls[0].(*ast.FuncDecl).Body.List
+		if len(body) != 1 {
+			return nil, fmt.Errorf("it has %d statements instead of one", len(body))
+		}
+		parsed = body[0]
+	} else {
+		expr, err := parser.ParseExpr(src)
+		if err != nil {
+			return nil, err
+		}
+		parsed = expr
+	}
+
+	if reflect.TypeOf(parsed) != reflect.TypeOf(node) {
+		return nil, fmt.Errorf("it is a %s instead of a %s", reflect.TypeOf(parsed).Elem().Name(), reflect.TypeOf(node).Elem().Name())
+	}
+
+	movePositions(parsed, node.Pos())
+
+	return parsed, nil
+}
+
+var posType = reflect.TypeOf(token.NoPos)
+
+// movePositions moves all positions of the parsed node to the given position, since they belong to another file.
+func movePositions(node ast.Node, pos token.Pos) {
+	ast.Inspect(node, func(n ast.Node) bool {
+		if n == nil {
+			return false
+		}
+
+		v := reflect.ValueOf(n).Elem()
+		for i := 0; i < v.NumField(); i++ {
+			if v.Field(i).Type() == posType {
+				v.Field(i).SetInt(int64(pos))
+			}
+		}
+
+		return true
+	})
+}
+
+// replace returns the mutation which replaces the node in place with the replacement of the same type.
+func replace(node ast.Node, replacement ast.Node) mutator.Mutation {
+	n := reflect.ValueOf(node).Elem()
+
+	original := reflect.New(n.Type()).Elem()
+	original.Set(n)
+
+	return mutator.Mutation{
+		Change: func() {
+			n.Set(reflect.ValueOf(replacement).Elem())
+		},
+		Reset: func() {
+			n.Set(original)
+		},
+	}
+}
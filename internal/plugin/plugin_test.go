@@ -0,0 +1,117 @@
+package plugin
+
+import (
+	"bytes"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/VirtualRoyalty/go-mutesting/internal/models"
+)
+
+func testPlugin(t *testing.T, nodes ...string) *Plugin {
+	executable := filepath.Join(t.TempDir(), "plugin")
+	build := exec.Command("go", "build", "-o", executable, "../../testdata/plugin")
+	output, err := build.CombinedOutput()
+	assert.NoError(t, err, string(output))
+
+	p, err := New(models.Plugin{
+		Name:    "plugin/test",
+		Command: []string{executable},
+		Nodes:   nodes,
+	})
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, p.Close())
+	})
+
+	return p
+}
+
+func mutateSource(t *testing.T, p *Plugin, src string) []string {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "a.go", src, 0)
+	assert.NoError(t, err)
+
+	print := func() string {
+		var buf bytes.Buffer
+		assert.NoError(t, printer.Fprint(&buf, fset, file))
+
+		return buf.String()
+	}
+	original := print()
+
+	var mutated []string
+	m := p.Mutator()
+	ast.Inspect(file, func(node ast.Node) bool {
+		if node == nil {
+			return false
+		}
+
+		for _, mutation := range m(nil, nil, node) {
+			mutation.Change()
+			mutated = append(mutated, print())
+			mutation.Reset()
+
+			assert.Equal(t, original, print())
+		}
+
+		return true
+	})
+
+	return mutated
+}
+
+func TestPluginMutator(t *testing.T) {
+	p := testPlugin(t, "BasicLit", "IncDecStmt")
+
+	mutated := mutateSource(t, p, "package a\n\nfunc f() {\n\ti := 1\n\ti++\n}\n")
+	assert.NoError(t, p.Err())
+	assert.Equal(t, []string{
+		"package a\n\nfunc f() {\n\ti := 3\n\ti++\n}\n",
+		"package a\n\nfunc f() {\n\ti := 1\n\ti--\n}\n",
+	}, mutated)
+}
+
+func TestPluginMutatorKinds(t *testing.T) {
+	p := testPlugin(t, "IncDecStmt")
+
+	mutated := mutateSource(t, p, "package a\n\nfunc f() {\n\ti := 1\n\ti++\n}\n")
+	assert.NoError(t, p.Err())
+	assert.Equal(t, []string{
+		"package a\n\nfunc f() {\n\ti := 1\n\ti--\n}\n",
+	}, mutated)
+}
+
+func TestPluginMutatorError(t *testing.T) {
+	p := testPlugin(t, "BasicLit")
+
+	mutated := mutateSource(t, p, "package a\n\nvar a, b = 42, 1\n")
+	assert.Empty(t, mutated)
+	assert.EqualError(t, p.Err(), "the answer can not be mutated")
+}
+
+func TestPluginMutatorInvalidMutation(t *testing.T) {
+	p := testPlugin(t, "IncDecStmt")
+
+	mutated := mutateSource(t, p, "package a\n\nfunc f() {\n\tj := 1\n\tj++\n}\n")
+	assert.Empty(t, mutated)
+	assert.EqualError(t, p.Err(), `mutation "j = 0" of "j++" is not valid: it is a AssignStmt instead of a IncDecStmt`)
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(models.Plugin{Command: []string{"plugin"}, Nodes: []string{"CallExpr"}})
+	assert.EqualError(t, err, `plugin with the command ["plugin"] has no name`)
+
+	_, err = New(models.Plugin{Name: "dsl/builder", Nodes: []string{"CallExpr"}})
+	assert.EqualError(t, err, `plugin "dsl/builder" has no command`)
+
+	_, err = New(models.Plugin{Name: "dsl/builder", Command: []string{"plugin"}})
+	assert.EqualError(t, err, `plugin "dsl/builder" has no node kinds`)
+}
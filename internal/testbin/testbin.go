@@ -0,0 +1,141 @@
+package testbin
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Result holds the outcome of the tests of a mutated package, the exit code is the same as of "go test" while 2 states that the tests did not compile.
+type Result struct {
+	ExitCode int
+	Output   []byte
+}
+
+// Runner compiles the test binary of a mutated package with "go test -c" and executes it on its own.
+// Results are reused for mutations which compile to the same test binary, e.g. because the compiler removed the mutated code.
+type Runner struct {
+	lock     sync.Mutex
+	goBinary string
+	tags     string
+	prepare  func(cmd *exec.Cmd)
+	results  map[string]Result
+}
+
+// NewRunner creates a new runner which compiles the tests with the given Go command and comma separated build tags.
+// The Go command on the PATH is used if the given Go command is empty.
+// The prepare function is called for every command before it is started, e.g. to limit its resources, and can be nil.
+func NewRunner(goBinary string, tags string, prepare func(cmd *exec.Cmd)) *Runner {
+	if goBinary == "" {
+		goBinary = "go"
+	}
+	if prepare == nil {
+		prepare = func(cmd *exec.Cmd) {}
+	}
+
+	return &Runner{
+		goBinary: goBinary,
+		tags:     tags,
+		prepare:  prepare,
+		results:  map[string]Result{},
+	}
+}
+
+// Test compiles the tests of the package in the given directory with the overlay into the binary file and executes the tests which match the run pattern.
+// An empty run pattern executes all tests.
+func (r *Runner) Test(dir string, overlayFile string, binaryFile string, timeout time.Duration, run string) (Result, error) {
+	binaryFile, err := filepath.Abs(binaryFile)
+	if err != nil {
+		return Result{}, err
+	}
+	defer func() {
+		_ = os.Remove(binaryFile)
+	}()
+
+	// Without a build ID the binaries of mutations which compile to the same code are identical
+	build := exec.Command(r.goBinary, "test", "-c", "-o", binaryFile, "-ldflags=-buildid=", "-tags", r.tags)
+	if overlayFile != "" {
+		build.Args = append(build.Args, "-overlay", overlayFile)
+	}
+	build.Args = append(build.Args, ".")
+	build.Dir = dir
+	build.Env = os.Environ()
+	r.prepare(build)
+
+	out, err := build.CombinedOutput()
+	if _, ok := err.(*exec.ExitError); ok {
+		return Result{
+			ExitCode: 2,
+			Output:   out,
+		}, nil
+	} else if err != nil {
+		return Result{}, err
+	}
+
+	// Packages without tests have no test binary
+	if _, err := os.Stat(binaryFile); os.IsNotExist(err) {
+		return Result{
+			Output: out,
+		}, nil
+	}
+
+	checksum, err := fileChecksum(binaryFile)
+	if err != nil {
+		return Result{}, err
+	}
+	key := checksum + "\x00" + run
+
+	r.lock.Lock()
+	result, ok := r.results[key]
+	r.lock.Unlock()
+	if ok {
+		return result, nil
+	}
+
+	args := []string{fmt.Sprintf("-test.timeout=%s", timeout)}
+	if run != "" {
+		args = append(args, "-test.run", run)
+	}
+
+	// "go test" executes the test binary in the directory of the package as well
+	test := exec.Command(binaryFile, args...)
+	test.Dir = dir
+	test.Env = os.Environ()
+	r.prepare(test)
+
+	out, err = test.CombinedOutput()
+	if _, ok := err.(*exec.ExitError); ok {
+		result.ExitCode = 1
+	} else if err != nil {
+		return Result{}, err
+	}
+	result.Output = out
+
+	r.lock.Lock()
+	r.results[key] = result
+	r.lock.Unlock()
+
+	return result, nil
+}
+
+func fileChecksum(file string) (string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
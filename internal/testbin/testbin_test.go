@@ -0,0 +1,65 @@
+package testbin
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunnerTest(t *testing.T) {
+	runner := NewRunner("", "", nil)
+	dir := t.TempDir()
+
+	result, err := runner.Test("testdata/calc", "", filepath.Join(dir, "calc.test"), time.Minute, "")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, result.ExitCode)
+	assert.Len(t, runner.results, 1)
+
+	// Sub is not tested and therefore not part of the test binary
+	result, err = runner.Test("testdata/calc", mutation(t, dir, "return a - b", "return a * b"), filepath.Join(dir, "calc.test"), time.Minute, "")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, result.ExitCode)
+	assert.Len(t, runner.results, 1)
+
+	result, err = runner.Test("testdata/calc", mutation(t, dir, "return a + b", "return a - b"), filepath.Join(dir, "calc.test"), time.Minute, "")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, result.ExitCode)
+	assert.Len(t, runner.results, 2)
+
+	result, err = runner.Test("testdata/calc", mutation(t, dir, "return a + b", "return a +"), filepath.Join(dir, "calc.test"), time.Minute, "")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, result.ExitCode)
+	assert.Len(t, runner.results, 2)
+
+	_, err = os.Stat(filepath.Join(dir, "calc.test"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+// mutation writes a mutation of the calc package and returns the overlay file which replaces the original file with it.
+func mutation(t *testing.T, dir string, old string, new string) string {
+	original, err := filepath.Abs("testdata/calc/calc.go")
+	assert.NoError(t, err)
+
+	content, err := os.ReadFile(original)
+	assert.NoError(t, err)
+
+	mutationFile := filepath.Join(dir, "calc.go")
+	assert.NoError(t, os.WriteFile(mutationFile, []byte(strings.Replace(string(content), old, new, 1)), 0666))
+
+	overlay, err := json.Marshal(map[string]map[string]string{
+		"Replace": {
+			original: mutationFile,
+		},
+	})
+	assert.NoError(t, err)
+
+	overlayFile := filepath.Join(dir, "overlay.json")
+	assert.NoError(t, os.WriteFile(overlayFile, overlay, 0666))
+
+	return overlayFile
+}
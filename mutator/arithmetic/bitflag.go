@@ -0,0 +1,136 @@
+package arithmetic
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("arithmetic/bitflag", MutatorArithmeticBitFlag)
+}
+
+var bitFlagMutations = map[token.Token]token.Token{
+	token.OR:             token.AND_NOT,
+	token.AND_NOT:        token.OR,
+	token.OR_ASSIGN:      token.AND_NOT_ASSIGN,
+	token.AND_NOT_ASSIGN: token.OR_ASSIGN,
+	token.EQL:            token.NEQ,
+	token.NEQ:            token.EQL,
+}
+
+// MutatorArithmeticBitFlag implements a mutator to change bit-flag expressions.
+// Setting a flag "flags | F" is changed to clearing it "flags &^ F" and vice versa, and the polarity of a flag test "flags&F != 0" is inverted.
+// Only expressions with an integer operand and a constant flag are mutated.
+func MutatorArithmeticBitFlag(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	if info == nil {
+		return nil
+	}
+
+	switch n := node.(type) {
+	case *ast.BinaryExpr:
+		mutated, ok := bitFlagMutations[n.Op]
+		if !ok {
+			return nil
+		}
+
+		switch n.Op {
+		case token.EQL, token.NEQ:
+			if !isBitFlagTest(info, n) {
+				return nil
+			}
+		default:
+			if !isBitFlag(info, n.X, n.Y) {
+				return nil
+			}
+		}
+
+		original := n.Op
+
+		return []mutator.Mutation{
+			{
+				Change: func() {
+					n.Op = mutated
+				},
+				Reset: func() {
+					n.Op = original
+				},
+			},
+		}
+	case *ast.AssignStmt:
+		mutated, ok := bitFlagMutations[n.Tok]
+		if !ok || len(n.Lhs) != 1 || len(n.Rhs) != 1 {
+			return nil
+		}
+
+		if n.Tok != token.OR_ASSIGN && n.Tok != token.AND_NOT_ASSIGN {
+			return nil
+		}
+
+		if !isBitFlag(info, n.Lhs[0], n.Rhs[0]) {
+			return nil
+		}
+
+		original := n.Tok
+
+		return []mutator.Mutation{
+			{
+				Change: func() {
+					n.Tok = mutated
+				},
+				Reset: func() {
+					n.Tok = original
+				},
+			},
+		}
+	}
+
+	return nil
+}
+
+// isBitFlagTest checks if the comparison tests a flag e.g. "flags&F != 0".
+func isBitFlagTest(info *types.Info, n *ast.BinaryExpr) bool {
+	if !isConstant(info, n.Y) {
+		return false
+	}
+
+	x := n.X
+	for {
+		p, ok := x.(*ast.ParenExpr)
+		if !ok {
+			break
+		}
+		x = p.X
+	}
+
+	and, ok := x.(*ast.BinaryExpr)
+	if !ok || and.Op != token.AND {
+		return false
+	}
+
+	return isBitFlag(info, and.X, and.Y)
+}
+
+// isBitFlag checks if the given operands are an integer value and a constant flag.
+func isBitFlag(info *types.Info, flags ast.Expr, flag ast.Expr) bool {
+	if !isConstant(info, flag) || isConstant(info, flags) {
+		return false
+	}
+
+	t := info.TypeOf(flags)
+	if t == nil {
+		return false
+	}
+
+	b, ok := t.Underlying().(*types.Basic)
+
+	return ok && b.Info()&types.IsInteger != 0
+}
+
+func isConstant(info *types.Info, x ast.Expr) bool {
+	tv, ok := info.Types[x]
+
+	return ok && tv.Value != nil
+}
@@ -0,0 +1,16 @@
+package arithmetic
+
+import (
+	"testing"
+
+	"github.com/VirtualRoyalty/go-mutesting/test"
+)
+
+func TestMutatorArithmeticBitFlag(t *testing.T) {
+	test.Mutator(
+		t,
+		MutatorArithmeticBitFlag,
+		"../../testdata/arithmetic/bitflag.go",
+		6,
+	)
+}
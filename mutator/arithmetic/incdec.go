@@ -0,0 +1,43 @@
+package arithmetic
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("arithmetic/incdec", MutatorArithmeticIncDec)
+}
+
+var incDecMutations = map[token.Token]token.Token{
+	token.INC: token.DEC,
+	token.DEC: token.INC,
+}
+
+// MutatorArithmeticIncDec implements a mutator to swap increment and decrement statements.
+func MutatorArithmeticIncDec(_ *types.Package, _ *types.Info, node ast.Node) []mutator.Mutation {
+	n, ok := node.(*ast.IncDecStmt)
+	if !ok {
+		return nil
+	}
+
+	original := n.Tok
+	mutated, ok := incDecMutations[n.Tok]
+	if !ok {
+		return nil
+	}
+
+	return []mutator.Mutation{
+		{
+			Change: func() {
+				n.Tok = mutated
+			},
+			Reset: func() {
+				n.Tok = original
+			},
+		},
+	}
+}
@@ -0,0 +1,16 @@
+package arithmetic
+
+import (
+	"testing"
+
+	"github.com/VirtualRoyalty/go-mutesting/test"
+)
+
+func TestMutatorArithmeticIncDec(t *testing.T) {
+	test.Mutator(
+		t,
+		MutatorArithmeticIncDec,
+		"../../testdata/arithmetic/incdec.go",
+		3,
+	)
+}
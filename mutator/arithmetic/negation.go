@@ -0,0 +1,77 @@
+package arithmetic
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+
+	"github.com/VirtualRoyalty/go-mutesting/astutil"
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("arithmetic/negation", MutatorArithmeticNegation)
+}
+
+// signedBits are the sizes of the signed integer types whose constants can overflow if their sign is removed.
+var signedBits = map[types.BasicKind]uint{
+	types.Int:   64,
+	types.Int8:  8,
+	types.Int16: 16,
+	types.Int32: 32,
+	types.Int64: 64,
+}
+
+// MutatorArithmeticNegation implements a mutator to remove the unary minus of numeric expressions, e.g. -x is replaced by x in its parent.
+func MutatorArithmeticNegation(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	if info == nil {
+		return nil
+	}
+
+	var mutations []mutator.Mutation
+
+	for _, x := range astutil.ValueExprs(node) {
+		n, ok := (*x).(*ast.UnaryExpr)
+		if !ok || n.Op != token.SUB || !isNegatable(info, n) {
+			continue
+		}
+
+		x := x
+		original := *x
+		mutated := n.X
+
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				*x = mutated
+			},
+			Reset: func() {
+				*x = original
+			},
+		})
+	}
+
+	return mutations
+}
+
+// isNegatable returns true if the unary minus is numeric and its operand still fits into its type without the minus, e.g. -128 of an int8 is not negatable.
+func isNegatable(info *types.Info, n *ast.UnaryExpr) bool {
+	tv, ok := info.Types[n]
+	if !ok {
+		return false
+	}
+
+	basic, ok := tv.Type.Underlying().(*types.Basic)
+	if !ok || basic.Info()&types.IsNumeric == 0 {
+		return false
+	}
+
+	bits, ok := signedBits[basic.Kind()]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.Int {
+		return true
+	}
+
+	max := constant.Shift(constant.MakeInt64(1), token.SHL, bits-1)
+
+	return constant.Compare(constant.UnaryOp(token.SUB, tv.Value, 0), token.LSS, max)
+}
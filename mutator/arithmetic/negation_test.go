@@ -0,0 +1,16 @@
+package arithmetic
+
+import (
+	"testing"
+
+	"github.com/VirtualRoyalty/go-mutesting/test"
+)
+
+func TestMutatorArithmeticNegation(t *testing.T) {
+	test.Mutator(
+		t,
+		MutatorArithmeticNegation,
+		"../../testdata/arithmetic/negation.go",
+		4,
+	)
+}
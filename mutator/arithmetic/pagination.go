@@ -0,0 +1,133 @@
+package arithmetic
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/VirtualRoyalty/go-mutesting/astutil"
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.RegisterConfigurable("arithmetic/pagination", NewMutatorArithmeticPagination)
+}
+
+// defaultPaginationNames are the parts of names of pagination values if the "names" parameter is not set.
+var defaultPaginationNames = []string{"limit", "offset", "page", "size"}
+
+var defaultPagination = newPaginationMutator(defaultPaginationNames)
+
+// paginationOperators are the operators of pagination arithmetic, e.g. offset+limit and page*size.
+var paginationOperators = map[token.Token]struct{}{
+	token.ADD: {},
+	token.SUB: {},
+	token.MUL: {},
+	token.QUO: {},
+}
+
+// MutatorArithmeticPagination implements a mutator to drop the right operand of pagination arithmetic, e.g. offset+limit is replaced by offset.
+// Both operands must contain an identifier which is named like a pagination value.
+func MutatorArithmeticPagination(pkg *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	return defaultPagination(pkg, info, node)
+}
+
+// NewMutatorArithmeticPagination returns a mutator to drop the right operand of pagination arithmetic
+// whose operands contain identifiers with the case-insensitive parts of the "names" parameter.
+func NewMutatorArithmeticPagination(config mutator.Config) (mutator.Mutator, error) {
+	err := config.Check("names")
+	if err != nil {
+		return nil, err
+	}
+
+	names, err := config.Strings("names", defaultPaginationNames)
+	if err != nil {
+		return nil, err
+	}
+
+	return newPaginationMutator(names), nil
+}
+
+func newPaginationMutator(names []string) mutator.Mutator {
+	lower := make([]string, len(names))
+	for i, name := range names {
+		lower[i] = strings.ToLower(name)
+	}
+
+	return func(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+		if info == nil {
+			return nil
+		}
+
+		// The arithmetic is replaced by its left operand in its parent
+		var mutations []mutator.Mutation
+		for _, x := range astutil.ValueExprs(node) {
+			if mutation, ok := mutatePagination(lower, info, x); ok {
+				mutations = append(mutations, mutation)
+			}
+		}
+
+		return mutations
+	}
+}
+
+// mutatePagination replaces the expression if it is pagination arithmetic with its left operand.
+func mutatePagination(names []string, info *types.Info, x *ast.Expr) (mutator.Mutation, bool) {
+	n, ok := (*x).(*ast.BinaryExpr)
+	if !ok {
+		return mutator.Mutation{}, false
+	}
+	if _, ok := paginationOperators[n.Op]; !ok {
+		return mutator.Mutation{}, false
+	}
+
+	t, ok := info.TypeOf(n).(*types.Basic)
+	if !ok || t.Info()&types.IsInteger == 0 || info.Types[n].Value != nil {
+		return mutator.Mutation{}, false
+	}
+	// The left operand must have the type of the arithmetic, e.g. it must not be an untyped constant
+	if !types.Identical(info.TypeOf(n.X), t) {
+		return mutator.Mutation{}, false
+	}
+
+	if !namedLikePagination(names, n.X) || !namedLikePagination(names, n.Y) {
+		return mutator.Mutation{}, false
+	}
+
+	original := *x
+	mutated := n.X
+
+	return mutator.Mutation{
+		Change: func() {
+			*x = mutated
+		},
+		Reset: func() {
+			*x = original
+		},
+	}, true
+}
+
+// namedLikePagination checks if the expression contains an identifier whose name contains one of the given lower case names.
+func namedLikePagination(names []string, x ast.Expr) bool {
+	found := false
+	ast.Inspect(x, func(node ast.Node) bool {
+		id, ok := node.(*ast.Ident)
+		if !ok || found {
+			return !found
+		}
+
+		name := strings.ToLower(id.Name)
+		for _, n := range names {
+			if strings.Contains(name, n) {
+				found = true
+
+				break
+			}
+		}
+
+		return !found
+	})
+
+	return found
+}
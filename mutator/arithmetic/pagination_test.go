@@ -0,0 +1,41 @@
+package arithmetic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/VirtualRoyalty/go-mutesting"
+	"github.com/VirtualRoyalty/go-mutesting/internal/parser"
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+	"github.com/VirtualRoyalty/go-mutesting/test"
+)
+
+func TestMutatorArithmeticPagination(t *testing.T) {
+	test.Mutator(
+		t,
+		MutatorArithmeticPagination,
+		"../../testdata/arithmetic/pagination.go",
+		4,
+	)
+}
+
+func TestNewMutatorArithmeticPagination(t *testing.T) {
+	src, _, pkg, info, err := parser.ParseAndTypeCheckFile("../../testdata/arithmetic/pagination.go", "", nil)
+	assert.NoError(t, err)
+
+	for count, names := range map[int][]interface{}{
+		2: {"offset", "LIMIT"},
+		6: {"offset", "limit", "page", "size", "total", "count"},
+		0: {},
+	} {
+		m, err := NewMutatorArithmeticPagination(mutator.Config{"names": names})
+		assert.NoError(t, err)
+		assert.Equal(t, count, mutesting.CountWalk(pkg, info, src, m), names)
+	}
+
+	for _, config := range []mutator.Config{{"names": "offset"}, {"pattern": []string{"offset"}}} {
+		_, err := NewMutatorArithmeticPagination(config)
+		assert.Error(t, err, config)
+	}
+}
@@ -0,0 +1,79 @@
+package arithmetic
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strconv"
+
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("arithmetic/shift", MutatorArithmeticShift)
+}
+
+// MutatorArithmeticShift implements a mutator to shift by one more bit, e.g. x << n is replaced by x << (n + 1).
+// Swapping the direction of shifts is left to arithmetic/bitwise.
+// Constant shifts are not mutated since an untyped constant can overflow the type of its use and a constant zero can be a divisor.
+func MutatorArithmeticShift(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	if info == nil {
+		return nil
+	}
+
+	var count *ast.Expr
+
+	switch n := node.(type) {
+	case *ast.BinaryExpr:
+		if n.Op != token.SHL && n.Op != token.SHR {
+			return nil
+		} else if info.Types[n].Value != nil {
+			return nil
+		}
+
+		count = &n.Y
+	case *ast.AssignStmt:
+		if (n.Tok != token.SHL_ASSIGN && n.Tok != token.SHR_ASSIGN) || len(n.Rhs) != 1 {
+			return nil
+		}
+
+		count = &n.Rhs[0]
+	default:
+		return nil
+	}
+
+	original := *count
+	mutated := incrementCount(original)
+
+	return []mutator.Mutation{
+		{
+			Change: func() {
+				*count = mutated
+			},
+			Reset: func() {
+				*count = original
+			},
+		},
+	}
+}
+
+// incrementCount returns the shift count plus one, integer literals are incremented directly.
+func incrementCount(count ast.Expr) ast.Expr {
+	if lit, ok := count.(*ast.BasicLit); ok && lit.Kind == token.INT {
+		if value, err := strconv.ParseUint(lit.Value, 0, 63); err == nil {
+			return &ast.BasicLit{
+				Kind:  token.INT,
+				Value: strconv.FormatUint(value+1, 10),
+			}
+		}
+	}
+
+	return &ast.BinaryExpr{
+		X:  count,
+		Op: token.ADD,
+		Y: &ast.BasicLit{
+			Kind:  token.INT,
+			Value: "1",
+		},
+	}
+}
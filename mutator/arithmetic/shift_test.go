@@ -0,0 +1,16 @@
+package arithmetic
+
+import (
+	"testing"
+
+	"github.com/VirtualRoyalty/go-mutesting/test"
+)
+
+func TestMutatorArithmeticShift(t *testing.T) {
+	test.Mutator(
+		t,
+		MutatorArithmeticShift,
+		"../../testdata/arithmetic/shift.go",
+		3,
+	)
+}
@@ -0,0 +1,80 @@
+package branch
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("branch/nil_check", MutatorNilCheck)
+}
+
+// MutatorNilCheck implements a mutator to remove the nil guards of if statements, e.g. "if x == nil".
+// The guard is made false to drop its body and true to always execute its body, the compared value is kept in the condition since it might not be used anywhere else.
+// Skipping the body of "if err != nil" is left to errors/return_nil.
+func MutatorNilCheck(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	if info == nil {
+		return nil
+	}
+
+	n, ok := node.(*ast.IfStmt)
+	if !ok {
+		return nil
+	}
+
+	cond, ok := n.Cond.(*ast.BinaryExpr)
+	if !ok || (cond.Op != token.EQL && cond.Op != token.NEQ) {
+		return nil
+	}
+
+	checked := cond.X
+	if isNilValue(info, checked) {
+		checked = cond.Y
+	} else if !isNilValue(info, cond.Y) {
+		return nil
+	}
+
+	var mutations []mutator.Mutation
+
+	for _, guard := range []struct {
+		value string
+		op    token.Token
+	}{
+		{"false", token.LAND},
+		{"true", token.LOR},
+	} {
+		if guard.op == token.LAND && cond.Op == token.NEQ && isErrorType(info.TypeOf(checked)) {
+			continue
+		}
+
+		mutated := &ast.BinaryExpr{
+			X:  ast.NewIdent(guard.value),
+			Op: guard.op,
+			Y:  cond,
+		}
+
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				n.Cond = mutated
+			},
+			Reset: func() {
+				n.Cond = cond
+			},
+		})
+	}
+
+	return mutations
+}
+
+func isNilValue(info *types.Info, expr ast.Expr) bool {
+	tv, ok := info.Types[expr]
+
+	return ok && tv.IsNil()
+}
+
+func isErrorType(t types.Type) bool {
+	return t != nil && types.Identical(t, types.Universe.Lookup("error").Type())
+}
@@ -0,0 +1,16 @@
+package branch
+
+import (
+	"testing"
+
+	"github.com/VirtualRoyalty/go-mutesting/test"
+)
+
+func TestMutatorNilCheck(t *testing.T) {
+	test.Mutator(
+		t,
+		MutatorNilCheck,
+		"../../testdata/branch/nilcheck.go",
+		5,
+	)
+}
@@ -0,0 +1,153 @@
+package branch
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/VirtualRoyalty/go-mutesting/astutil"
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("branch/remove_default", MutatorRemoveDefault)
+}
+
+// MutatorRemoveDefault implements a mutator to empty the bodies of the default clauses of switch and select statements.
+// The mutator works on functions as a switch or select statement which terminates a function with results would miss a return statement without its default clause. Such statements are not mutated.
+func MutatorRemoveDefault(pkg *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	if info == nil {
+		return nil
+	}
+
+	var typ *ast.FuncType
+	var body *ast.BlockStmt
+
+	switch n := node.(type) {
+	case *ast.FuncDecl:
+		typ, body = n.Type, n.Body
+	case *ast.FuncLit:
+		typ, body = n.Type, n.Body
+	}
+	if body == nil {
+		return nil
+	}
+
+	terminating := map[ast.Stmt]bool{}
+	if typ.Results != nil && len(typ.Results.List) > 0 {
+		terminatingBranches(body, terminating)
+	}
+
+	var mutations []mutator.Mutation
+
+	ast.Inspect(body, func(node ast.Node) bool {
+		var clauses *ast.BlockStmt
+
+		switch n := node.(type) {
+		case *ast.FuncLit:
+			// Function literals are mutated on their own
+			return false
+		case *ast.SwitchStmt:
+			clauses = n.Body
+		case *ast.TypeSwitchStmt:
+			clauses = n.Body
+		case *ast.SelectStmt:
+			clauses = n.Body
+		default:
+			return true
+		}
+		if terminating[node.(ast.Stmt)] {
+			return true
+		}
+
+		for _, clause := range clauses.List {
+			l := defaultClauseBody(clause)
+			if l == nil || len(*l) == 0 {
+				continue
+			}
+
+			old := *l
+
+			mutations = append(mutations, mutator.Mutation{
+				Change: func() {
+					*l = []ast.Stmt{
+						astutil.CreateNoopOfStatements(pkg, info, old),
+					}
+				},
+				Reset: func() {
+					*l = old
+				},
+				Pos: clause.Pos(),
+			})
+		}
+
+		return true
+	})
+
+	return mutations
+}
+
+// terminatingBranches collects the switch and select statements which terminate the given statement, so the function body would miss a return statement without their default clauses.
+func terminatingBranches(stmt ast.Stmt, branches map[ast.Stmt]bool) {
+	var clauses *ast.BlockStmt
+
+	switch s := stmt.(type) {
+	case *ast.BlockStmt:
+		if len(s.List) > 0 {
+			terminatingBranches(s.List[len(s.List)-1], branches)
+		}
+
+		return
+	case *ast.LabeledStmt:
+		terminatingBranches(s.Stmt, branches)
+
+		return
+	case *ast.IfStmt:
+		if s.Else != nil {
+			terminatingBranches(s.Body, branches)
+			terminatingBranches(s.Else, branches)
+		}
+
+		return
+	case *ast.SwitchStmt:
+		clauses = s.Body
+	case *ast.TypeSwitchStmt:
+		clauses = s.Body
+	case *ast.SelectStmt:
+		clauses = s.Body
+	default:
+		return
+	}
+
+	branches[stmt] = true
+
+	for _, clause := range clauses.List {
+		var l []ast.Stmt
+
+		switch c := clause.(type) {
+		case *ast.CaseClause:
+			l = c.Body
+		case *ast.CommClause:
+			l = c.Body
+		}
+
+		if len(l) > 0 {
+			terminatingBranches(l[len(l)-1], branches)
+		}
+	}
+}
+
+// defaultClauseBody returns a pointer to the body of the given clause if it is a default clause, otherwise nil.
+func defaultClauseBody(stmt ast.Stmt) *[]ast.Stmt {
+	switch n := stmt.(type) {
+	case *ast.CaseClause:
+		if n.List == nil {
+			return &n.Body
+		}
+	case *ast.CommClause:
+		if n.Comm == nil {
+			return &n.Body
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,16 @@
+package branch
+
+import (
+	"testing"
+
+	"github.com/VirtualRoyalty/go-mutesting/test"
+)
+
+func TestMutatorRemoveDefault(t *testing.T) {
+	test.Mutator(
+		t,
+		MutatorRemoveDefault,
+		"../../testdata/branch/removedefault.go",
+		3,
+	)
+}
@@ -0,0 +1,68 @@
+package branch
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/VirtualRoyalty/go-mutesting/astutil"
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("branch/select_case", MutatorSelectCase)
+}
+
+// MutatorSelectCase implements a mutator for the cases of select statements.
+// The body of every case is emptied and every case is removed unless it is the only case of the select statement.
+func MutatorSelectCase(pkg *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	if info == nil {
+		return nil
+	}
+
+	n, ok := node.(*ast.SelectStmt)
+	if !ok {
+		return nil
+	}
+
+	var mutations []mutator.Mutation
+
+	for i, stmt := range n.Body.List {
+		clause, ok := stmt.(*ast.CommClause)
+		if !ok {
+			continue
+		}
+
+		if len(clause.Body) > 0 {
+			old := clause.Body
+
+			mutations = append(mutations, mutator.Mutation{
+				Change: func() {
+					clause.Body = []ast.Stmt{
+						astutil.CreateNoopOfStatements(pkg, info, old),
+					}
+				},
+				Reset: func() {
+					clause.Body = old
+				},
+			})
+		}
+
+		if len(n.Body.List) > 1 {
+			i := i
+			old := n.Body.List
+
+			mutations = append(mutations, mutator.Mutation{
+				Change: func() {
+					list := make([]ast.Stmt, 0, len(old)-1)
+					list = append(list, old[:i]...)
+					n.Body.List = append(list, old[i+1:]...)
+				},
+				Reset: func() {
+					n.Body.List = old
+				},
+			})
+		}
+	}
+
+	return mutations
+}
@@ -0,0 +1,16 @@
+package branch
+
+import (
+	"testing"
+
+	"github.com/VirtualRoyalty/go-mutesting/test"
+)
+
+func TestMutatorSelectCase(t *testing.T) {
+	test.Mutator(
+		t,
+		MutatorSelectCase,
+		"../../testdata/branch/selectcase.go",
+		6,
+	)
+}
@@ -0,0 +1,115 @@
+package branch
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/VirtualRoyalty/go-mutesting/astutil"
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("branch/ternary", MutatorTernary)
+}
+
+// MutatorTernary implements a mutator for ternary-like if/else statements which assign the same variables in both branches.
+// The assigned values of one branch are used in the other branch as well, so the condition is still evaluated but does not matter anymore.
+func MutatorTernary(_ *types.Package, _ *types.Info, node ast.Node) []mutator.Mutation {
+	n, ok := node.(*ast.IfStmt)
+	if !ok {
+		return nil
+	}
+
+	// We ignore else ifs and if statements without an else branch
+	elseBody, ok := n.Else.(*ast.BlockStmt)
+	if !ok {
+		return nil
+	}
+
+	thenAssign := ternaryAssignment(n.Body)
+	elseAssign := ternaryAssignment(elseBody)
+	if thenAssign == nil || elseAssign == nil || !sameExprs(thenAssign.Lhs, elseAssign.Lhs) {
+		return nil
+	}
+
+	// The copies of the values have no positions, so the ellipsis of a call would be lost
+	if hasEllipsis(thenAssign.Rhs) || hasEllipsis(elseAssign.Rhs) {
+		return nil
+	}
+
+	thenValues := thenAssign.Rhs
+	elseValues := elseAssign.Rhs
+
+	return []mutator.Mutation{
+		{
+			Change: func() {
+				elseAssign.Rhs = cloneExprs(thenValues)
+			},
+			Reset: func() {
+				elseAssign.Rhs = elseValues
+			},
+		},
+		{
+			Change: func() {
+				thenAssign.Rhs = cloneExprs(elseValues)
+			},
+			Reset: func() {
+				thenAssign.Rhs = thenValues
+			},
+		},
+	}
+}
+
+// ternaryAssignment returns the assignment if it is the only statement of the block.
+func ternaryAssignment(block *ast.BlockStmt) *ast.AssignStmt {
+	if len(block.List) != 1 {
+		return nil
+	}
+
+	assign, ok := block.List[0].(*ast.AssignStmt)
+	if !ok || assign.Tok != token.ASSIGN || len(assign.Lhs) != len(assign.Rhs) {
+		return nil
+	}
+
+	return assign
+}
+
+func sameExprs(a []ast.Expr, b []ast.Expr) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if types.ExprString(a[i]) != types.ExprString(b[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// hasEllipsis checks if one of the expressions contains a call which passes a slice as its variadic arguments.
+func hasEllipsis(exprs []ast.Expr) bool {
+	found := false
+	for _, x := range exprs {
+		ast.Inspect(x, func(node ast.Node) bool {
+			if call, ok := node.(*ast.CallExpr); ok && call.Ellipsis.IsValid() {
+				found = true
+			}
+
+			return !found
+		})
+	}
+
+	return found
+}
+
+func cloneExprs(exprs []ast.Expr) []ast.Expr {
+	c := make([]ast.Expr, len(exprs))
+	for i, x := range exprs {
+		c[i] = astutil.CloneExpr(x)
+	}
+
+	return c
+}
@@ -0,0 +1,16 @@
+package branch
+
+import (
+	"testing"
+
+	"github.com/VirtualRoyalty/go-mutesting/test"
+)
+
+func TestMutatorTernary(t *testing.T) {
+	test.Mutator(
+		t,
+		MutatorTernary,
+		"../../testdata/branch/ternary.go",
+		4,
+	)
+}
@@ -0,0 +1,191 @@
+package concurrency
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/VirtualRoyalty/go-mutesting/astutil"
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("concurrency/atomic", MutatorAtomic)
+}
+
+const atomicPath = "sync/atomic"
+
+// atomicTypes are the suffixes of the sync/atomic functions of plain values, e.g. AddInt64.
+var atomicTypes = map[string]struct{}{
+	"Int32":   {},
+	"Int64":   {},
+	"Uint32":  {},
+	"Uint64":  {},
+	"Uintptr": {},
+	"Pointer": {},
+}
+
+// MutatorAtomic implements a mutator to replace the sync/atomic functions of plain values with direct access,
+// e.g. atomic.AddInt64(&x, 1) with x++ and atomic.LoadInt64(&x) with x, which reveals with the race detector whether the tests exercise the contended paths.
+// Add and Store calls are only replaced as statements since direct access has no result.
+func MutatorAtomic(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	if info == nil {
+		return nil
+	}
+
+	var mutations []mutator.Mutation
+
+	var l []ast.Stmt
+	switch n := node.(type) {
+	case *ast.BlockStmt:
+		l = n.List
+	case *ast.CaseClause:
+		l = n.Body
+	case *ast.CommClause:
+		l = n.Body
+	}
+
+	for i, stmt := range l {
+		if mutation, ok := mutateAtomicStatement(info, l, i, stmt); ok {
+			mutations = append(mutations, mutation)
+		}
+	}
+
+	for _, x := range astutil.ValueExprs(node) {
+		if mutation, ok := mutateAtomicLoad(info, x); ok {
+			mutations = append(mutations, mutation)
+		}
+	}
+
+	return mutations
+}
+
+// mutateAtomicStatement replaces an atomic Add or Store statement with an increment, decrement or assignment.
+func mutateAtomicStatement(info *types.Info, l []ast.Stmt, i int, stmt ast.Stmt) (mutator.Mutation, bool) {
+	expr, ok := stmt.(*ast.ExprStmt)
+	if !ok {
+		return mutator.Mutation{}, false
+	}
+
+	call, name, ok := atomicCall(info, expr.X, 2)
+	if !ok {
+		return mutator.Mutation{}, false
+	}
+
+	target := dereference(call.Args[0])
+	value := call.Args[1]
+
+	var mutated ast.Stmt
+	switch {
+	case strings.HasPrefix(name, "Add"):
+		mutated = &ast.AssignStmt{
+			Lhs: []ast.Expr{target},
+			Tok: token.ADD_ASSIGN,
+			Rhs: []ast.Expr{value},
+		}
+
+		if v := info.Types[value].Value; v != nil && v.Kind() == constant.Int {
+			if constant.Compare(v, token.EQL, constant.MakeInt64(1)) {
+				mutated = &ast.IncDecStmt{X: target, Tok: token.INC}
+			} else if constant.Compare(v, token.EQL, constant.MakeInt64(-1)) {
+				mutated = &ast.IncDecStmt{X: target, Tok: token.DEC}
+			}
+		}
+	case strings.HasPrefix(name, "Store"):
+		mutated = &ast.AssignStmt{
+			Lhs: []ast.Expr{target},
+			Tok: token.ASSIGN,
+			Rhs: []ast.Expr{value},
+		}
+	default:
+		return mutator.Mutation{}, false
+	}
+
+	return mutator.Mutation{
+		Change: func() {
+			l[i] = mutated
+		},
+		Reset: func() {
+			l[i] = stmt
+		},
+		Pos: stmt.Pos(),
+	}, true
+}
+
+// mutateAtomicLoad replaces the expression if it is an atomic Load with a direct read.
+func mutateAtomicLoad(info *types.Info, x *ast.Expr) (mutator.Mutation, bool) {
+	call, name, ok := atomicCall(info, *x, 1)
+	if !ok || !strings.HasPrefix(name, "Load") {
+		return mutator.Mutation{}, false
+	}
+
+	original := *x
+	mutated := dereference(call.Args[0])
+
+	return mutator.Mutation{
+		Change: func() {
+			*x = mutated
+		},
+		Reset: func() {
+			*x = original
+		},
+	}, true
+}
+
+// atomicCall returns the call and the function name if the expression calls a sync/atomic function of plain values with the given count of arguments,
+// sync/atomic must still be used after the call is replaced.
+func atomicCall(info *types.Info, x ast.Expr, args int) (*ast.CallExpr, string, bool) {
+	call, ok := x.(*ast.CallExpr)
+	if !ok || len(call.Args) != args {
+		return nil, "", false
+	}
+
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return nil, "", false
+	}
+
+	id, ok := sel.X.(*ast.Ident)
+	if !ok {
+		return nil, "", false
+	}
+
+	pkgName, ok := info.Uses[id].(*types.PkgName)
+	if !ok || pkgName.Imported().Path() != atomicPath {
+		return nil, "", false
+	}
+
+	name := sel.Sel.Name
+	typeName := ""
+	for _, operation := range []string{"Add", "Load", "Store"} {
+		if strings.HasPrefix(name, operation) {
+			typeName = strings.TrimPrefix(name, operation)
+		}
+	}
+	if _, ok := atomicTypes[typeName]; !ok {
+		return nil, "", false
+	}
+
+	uses := 0
+	for _, obj := range info.Uses {
+		if obj == pkgName {
+			uses++
+		}
+	}
+	if uses < 2 {
+		return nil, "", false
+	}
+
+	return call, name, true
+}
+
+// dereference returns the expression which the pointer points to, e.g. x for &x and *p for p.
+func dereference(pointer ast.Expr) ast.Expr {
+	if unary, ok := pointer.(*ast.UnaryExpr); ok && unary.Op == token.AND {
+		return unary.X
+	}
+
+	return &ast.StarExpr{X: pointer}
+}
@@ -0,0 +1,16 @@
+package concurrency
+
+import (
+	"testing"
+
+	"github.com/VirtualRoyalty/go-mutesting/test"
+)
+
+func TestMutatorAtomic(t *testing.T) {
+	test.Mutator(
+		t,
+		MutatorAtomic,
+		"../../testdata/concurrency/atomic.go",
+		7,
+	)
+}
@@ -0,0 +1,72 @@
+package concurrency
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/VirtualRoyalty/go-mutesting/astutil"
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("concurrency/channel", MutatorChannel)
+}
+
+// MutatorChannel implements a mutator to remove channel send statements and receive operations which are used as statements.
+// The statement is replaced by an assignment of its channel and sent value to blank identifiers, so their variables stay used.
+func MutatorChannel(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	if info == nil {
+		return nil
+	}
+
+	var l []ast.Stmt
+
+	switch n := node.(type) {
+	case *ast.BlockStmt:
+		l = n.List
+	case *ast.CaseClause:
+		l = n.Body
+	case *ast.CommClause:
+		l = n.Body
+	}
+
+	var mutations []mutator.Mutation
+
+	for i, stmt := range l {
+		exprs := channelExprs(stmt)
+		if exprs == nil {
+			continue
+		}
+
+		li := i
+		old := l[li]
+		noop := astutil.CreateNoopOfExprs(info, exprs)
+
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				l[li] = noop
+			},
+			Reset: func() {
+				l[li] = old
+			},
+			Pos: old.Pos(),
+		})
+	}
+
+	return mutations
+}
+
+// channelExprs returns the channel and the sent value of "ch <- v" statements and the channel of "<-ch" statements, or nil for other statements.
+func channelExprs(stmt ast.Stmt) []ast.Expr {
+	switch n := stmt.(type) {
+	case *ast.SendStmt:
+		return []ast.Expr{n.Chan, n.Value}
+	case *ast.ExprStmt:
+		if u, ok := n.X.(*ast.UnaryExpr); ok && u.Op == token.ARROW {
+			return []ast.Expr{u.X}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,16 @@
+package concurrency
+
+import (
+	"testing"
+
+	"github.com/VirtualRoyalty/go-mutesting/test"
+)
+
+func TestMutatorChannel(t *testing.T) {
+	test.Mutator(
+		t,
+		MutatorChannel,
+		"../../testdata/concurrency/channel.go",
+		4,
+	)
+}
@@ -0,0 +1,53 @@
+package concurrency
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("concurrency/go_removal", MutatorGoRemoval)
+}
+
+// MutatorGoRemoval implements a mutator to call the functions of go statements synchronously.
+// Mutations which block forever, e.g. by sending on an unbuffered channel, are caught by the timeout of the exec command.
+func MutatorGoRemoval(_ *types.Package, _ *types.Info, node ast.Node) []mutator.Mutation {
+	var l []ast.Stmt
+
+	switch n := node.(type) {
+	case *ast.BlockStmt:
+		l = n.List
+	case *ast.CaseClause:
+		l = n.Body
+	case *ast.CommClause:
+		l = n.Body
+	}
+
+	var mutations []mutator.Mutation
+
+	for i, stmt := range l {
+		g, ok := stmt.(*ast.GoStmt)
+		if !ok {
+			continue
+		}
+
+		li := i
+		mutated := &ast.ExprStmt{
+			X: g.Call,
+		}
+
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				l[li] = mutated
+			},
+			Reset: func() {
+				l[li] = g
+			},
+			Pos: g.Pos(),
+		})
+	}
+
+	return mutations
+}
@@ -0,0 +1,16 @@
+package concurrency
+
+import (
+	"testing"
+
+	"github.com/VirtualRoyalty/go-mutesting/test"
+)
+
+func TestMutatorGoRemoval(t *testing.T) {
+	test.Mutator(
+		t,
+		MutatorGoRemoval,
+		"../../testdata/concurrency/go_removal.go",
+		2,
+	)
+}
@@ -0,0 +1,98 @@
+package concurrency
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/VirtualRoyalty/go-mutesting/astutil"
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("concurrency/mutex", MutatorMutex)
+}
+
+// mutexMethods are the full names of the locking methods of the sync package.
+var mutexMethods = map[string]struct{}{
+	"(*sync.Mutex).Lock":      {},
+	"(*sync.Mutex).Unlock":    {},
+	"(*sync.RWMutex).Lock":    {},
+	"(*sync.RWMutex).Unlock":  {},
+	"(*sync.RWMutex).RLock":   {},
+	"(*sync.RWMutex).RUnlock": {},
+	"(sync.Locker).Lock":      {},
+	"(sync.Locker).Unlock":    {},
+}
+
+// MutatorMutex implements a mutator to remove Lock, Unlock, RLock and RUnlock calls of the mutexes of the sync package, which is best combined with the race detector.
+func MutatorMutex(pkg *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	if info == nil {
+		return nil
+	}
+
+	var l []ast.Stmt
+
+	switch n := node.(type) {
+	case *ast.BlockStmt:
+		l = n.List
+	case *ast.CaseClause:
+		l = n.Body
+	case *ast.CommClause:
+		l = n.Body
+	}
+
+	var mutations []mutator.Mutation
+
+	for i, stmt := range l {
+		if !isMutexStatement(info, stmt) {
+			continue
+		}
+
+		li := i
+		old := l[li]
+
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				l[li] = astutil.CreateNoopOfStatement(pkg, info, old)
+			},
+			Reset: func() {
+				l[li] = old
+			},
+			Pos: old.Pos(),
+		})
+	}
+
+	return mutations
+}
+
+// isMutexStatement returns true for statements which call a locking method of the sync package, e.g. "mu.Lock()".
+func isMutexStatement(info *types.Info, stmt ast.Stmt) bool {
+	s, ok := stmt.(*ast.ExprStmt)
+	if !ok {
+		return false
+	}
+
+	call, ok := s.X.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+
+	selection, ok := info.Selections[sel]
+	if !ok {
+		return false
+	}
+
+	fn, ok := selection.Obj().(*types.Func)
+	if !ok {
+		return false
+	}
+
+	_, ok = mutexMethods[fn.FullName()]
+
+	return ok
+}
@@ -0,0 +1,16 @@
+package concurrency
+
+import (
+	"testing"
+
+	"github.com/VirtualRoyalty/go-mutesting/test"
+)
+
+func TestMutatorMutex(t *testing.T) {
+	test.Mutator(
+		t,
+		MutatorMutex,
+		"../../testdata/concurrency/mutex.go",
+		5,
+	)
+}
@@ -0,0 +1,117 @@
+package concurrency
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("concurrency/select_timeout", MutatorSelectTimeout)
+}
+
+// MutatorSelectTimeout implements a mutator for timeout cases of select statements.
+// The duration of a "case <-time.After(d):" clause is mutated to 0 and the clause is removed entirely.
+func MutatorSelectTimeout(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	if info == nil {
+		return nil
+	}
+
+	n, ok := node.(*ast.SelectStmt)
+	if !ok {
+		return nil
+	}
+
+	var mutations []mutator.Mutation
+
+	for i, stmt := range n.Body.List {
+		clause, ok := stmt.(*ast.CommClause)
+		if !ok {
+			continue
+		}
+
+		call := timeAfterCall(info, clause.Comm)
+		if call == nil || len(call.Args) != 1 {
+			continue
+		}
+
+		if lit, ok := call.Args[0].(*ast.BasicLit); !ok || lit.Kind != token.INT || lit.Value != "0" {
+			original := call.Args[0]
+			mutated := &ast.BasicLit{
+				Kind:  token.INT,
+				Value: "0",
+			}
+
+			mutations = append(mutations, mutator.Mutation{
+				Change: func() {
+					call.Args[0] = mutated
+				},
+				Reset: func() {
+					call.Args[0] = original
+				},
+			})
+		}
+
+		// A select without any clause blocks forever, so the only clause is kept
+		if len(n.Body.List) > 1 {
+			li := i
+			original := n.Body.List
+
+			mutations = append(mutations, mutator.Mutation{
+				Change: func() {
+					mutated := make([]ast.Stmt, 0, len(original)-1)
+					mutated = append(mutated, original[:li]...)
+					mutated = append(mutated, original[li+1:]...)
+
+					n.Body.List = mutated
+				},
+				Reset: func() {
+					n.Body.List = original
+				},
+			})
+		}
+	}
+
+	return mutations
+}
+
+// timeAfterCall returns the call of time.After which is received from by the given communication of a select clause.
+func timeAfterCall(info *types.Info, comm ast.Stmt) *ast.CallExpr {
+	var x ast.Expr
+
+	switch c := comm.(type) {
+	case *ast.ExprStmt:
+		x = c.X
+	case *ast.AssignStmt:
+		if len(c.Rhs) != 1 {
+			return nil
+		}
+		x = c.Rhs[0]
+	default:
+		return nil
+	}
+
+	recv, ok := x.(*ast.UnaryExpr)
+	if !ok || recv.Op != token.ARROW {
+		return nil
+	}
+
+	call, ok := recv.X.(*ast.CallExpr)
+	if !ok {
+		return nil
+	}
+
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return nil
+	}
+
+	fn, ok := info.Uses[sel.Sel].(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != "time" || fn.Name() != "After" {
+		return nil
+	}
+
+	return call
+}
@@ -0,0 +1,16 @@
+package concurrency
+
+import (
+	"testing"
+
+	"github.com/VirtualRoyalty/go-mutesting/test"
+)
+
+func TestMutatorSelectTimeout(t *testing.T) {
+	test.Mutator(
+		t,
+		MutatorSelectTimeout,
+		"../../testdata/concurrency/select_timeout.go",
+		4,
+	)
+}
@@ -0,0 +1,11 @@
+package conditional
+
+import (
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+	"github.com/VirtualRoyalty/go-mutesting/mutator/expression"
+)
+
+func init() {
+	// Moving the boundaries of relational operators is what expression/comparison does, which is enabled by default, so the mutator is opt-in
+	mutator.RegisterOptIn("conditional/boundary", expression.MutatorComparison)
+}
@@ -0,0 +1,22 @@
+package conditional
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+	"github.com/VirtualRoyalty/go-mutesting/test"
+)
+
+func TestMutatorConditionalBoundary(t *testing.T) {
+	m, err := mutator.New("conditional/boundary")
+	assert.NoError(t, err)
+
+	test.Mutator(
+		t,
+		m,
+		"../../testdata/conditional/boundary.go",
+		4,
+	)
+}
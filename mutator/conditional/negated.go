@@ -9,7 +9,8 @@ import (
 )
 
 func init() {
-	mutator.Register("conditional/negated", MutatorConditionalNegated)
+	// Negated comparisons are mostly caught by any test of the branch, so the mutator is opt-in
+	mutator.RegisterOptIn("conditional/negated", MutatorConditionalNegated)
 }
 
 var negatedMutations = map[token.Token]token.Token{
@@ -0,0 +1,142 @@
+package mutator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Config holds the parameters of a mutator which are set with the mutators config parameter.
+type Config map[string]interface{}
+
+// Factory returns a mutator with the given parameters, it returns an error if a parameter is unknown or invalid.
+// The parameters are nil if none are configured.
+type Factory func(config Config) (Mutator, error)
+
+var factoryLookup = make(map[string]Factory)
+
+// RegisterConfigurable registers a mutator with the given name whose instance function is returned by the factory for its parameters.
+// The factory is called without parameters for the default instance function.
+func RegisterConfigurable(name string, factory Factory) {
+	if factory == nil {
+		panic("mutator factory is nil")
+	}
+
+	m, err := factory(nil)
+	if err != nil {
+		panic(fmt.Sprintf("mutator %q has no default: %v", name, err))
+	}
+
+	Register(name, m)
+
+	factoryLookup[name] = factory
+}
+
+// NewConfigured returns a new mutator instance given the registered name of the mutator and its parameters.
+// The error return argument is not nil, if the name does not exist or the parameters are not valid for the mutator.
+func NewConfigured(name string, config Config) (Mutator, error) {
+	if len(config) == 0 {
+		return New(name)
+	}
+
+	factory, ok := factoryLookup[name]
+	if !ok {
+		if _, err := New(name); err != nil {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("mutator %q has no parameters", name)
+	}
+
+	return factory(config)
+}
+
+// IsConfigurable returns true if the mutator with the given name has parameters.
+func IsConfigurable(name string) bool {
+	_, ok := factoryLookup[name]
+
+	return ok
+}
+
+// Int returns the integer parameter with the given key or the default if it is not set.
+func (c Config) Int(key string, def int) (int, error) {
+	v, ok := c[key]
+	if !ok {
+		return def, nil
+	}
+
+	switch n := v.(type) {
+	case int:
+		return n, nil
+	case int64:
+		return int(n), nil
+	case float64:
+		if n == float64(int(n)) {
+			return int(n), nil
+		}
+	}
+
+	return 0, fmt.Errorf("parameter %q is not an integer but %v", key, v)
+}
+
+// String returns the string parameter with the given key or the default if it is not set.
+func (c Config) String(key string, def string) (string, error) {
+	v, ok := c[key]
+	if !ok {
+		return def, nil
+	}
+
+	s, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("parameter %q is not a string but %v", key, v)
+	}
+
+	return s, nil
+}
+
+// Strings returns the list of strings parameter with the given key or the default if it is not set.
+func (c Config) Strings(key string, def []string) ([]string, error) {
+	v, ok := c[key]
+	if !ok {
+		return def, nil
+	}
+
+	switch l := v.(type) {
+	case []string:
+		return l, nil
+	case []interface{}:
+		strs := make([]string, len(l))
+		for i, e := range l {
+			s, ok := e.(string)
+			if !ok {
+				return nil, fmt.Errorf("parameter %q is not a list of strings but has the element %v", key, e)
+			}
+			strs[i] = s
+		}
+
+		return strs, nil
+	}
+
+	return nil, fmt.Errorf("parameter %q is not a list of strings but %v", key, v)
+}
+
+// Check returns an error if the config has parameters other than the given keys.
+func (c Config) Check(keys ...string) error {
+	known := map[string]bool{}
+	for _, key := range keys {
+		known[key] = true
+	}
+
+	var unknown []string
+	for key := range c {
+		if !known[key] {
+			unknown = append(unknown, key)
+		}
+	}
+	if len(unknown) == 0 {
+		return nil
+	}
+	sort.Strings(unknown)
+
+	return fmt.Errorf("unknown parameters %s, the parameters are %s", strings.Join(unknown, ", "), strings.Join(keys, ", "))
+}
@@ -0,0 +1,100 @@
+package mutator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigurableMutator(t *testing.T) {
+	RegisterConfigurable("mock-configurable", func(config Config) (Mutator, error) {
+		if err := config.Check("fail"); err != nil {
+			return nil, err
+		}
+
+		if fail, _ := config.Int("fail", 0); fail != 0 {
+			return nil, assert.AnError
+		}
+
+		return mockMutator, nil
+	})
+	Register("mock-plain", mockMutator)
+
+	assert.True(t, IsConfigurable("mock-configurable"))
+	assert.False(t, IsConfigurable("mock-plain"))
+
+	m, err := New("mock-configurable")
+	assert.NotNil(t, m)
+	assert.NoError(t, err)
+
+	m, err = NewConfigured("mock-configurable", Config{"fail": 0})
+	assert.NotNil(t, m)
+	assert.NoError(t, err)
+
+	_, err = NewConfigured("mock-configurable", Config{"fail": 1})
+	assert.Equal(t, assert.AnError, err)
+
+	_, err = NewConfigured("mock-configurable", Config{"unknown": 1})
+	assert.EqualError(t, err, "unknown parameters unknown, the parameters are fail")
+
+	m, err = NewConfigured("mock-plain", nil)
+	assert.NotNil(t, m)
+	assert.NoError(t, err)
+
+	_, err = NewConfigured("mock-plain", Config{"fail": 1})
+	assert.EqualError(t, err, `mutator "mock-plain" has no parameters`)
+
+	_, err = NewConfigured("mock-unknown", Config{"fail": 1})
+	assert.EqualError(t, err, `unknown mutator "mock-unknown"`)
+}
+
+func TestConfigParameters(t *testing.T) {
+	config := Config{
+		"int":      3,
+		"float":    4.0,
+		"fraction": 4.5,
+		"string":   "a",
+		"strings":  []interface{}{"a", "b"},
+		"mixed":    []interface{}{"a", 1},
+	}
+
+	n, err := config.Int("int", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	n, err = config.Int("float", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, n)
+
+	n, err = config.Int("unset", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	_, err = config.Int("fraction", 1)
+	assert.Error(t, err)
+
+	str, err := config.String("string", "b")
+	assert.NoError(t, err)
+	assert.Equal(t, "a", str)
+
+	str, err = config.String("unset", "b")
+	assert.NoError(t, err)
+	assert.Equal(t, "b", str)
+
+	_, err = config.String("strings", "b")
+	assert.Error(t, err)
+
+	strs, err := config.Strings("strings", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, strs)
+
+	strs, err = config.Strings("unset", []string{"c"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"c"}, strs)
+
+	_, err = config.Strings("mixed", nil)
+	assert.Error(t, err)
+
+	_, err = config.Strings("int", nil)
+	assert.Error(t, err)
+}
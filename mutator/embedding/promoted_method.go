@@ -0,0 +1,115 @@
+package embedding
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("embedding/promoted_method", MutatorPromotedMethod)
+}
+
+// MutatorPromotedMethod implements a mutator to call a method through another embedded field which provides a method with the same name and signature.
+func MutatorPromotedMethod(pkg *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	if info == nil {
+		return nil
+	}
+
+	n, ok := node.(*ast.CallExpr)
+	if !ok {
+		return nil
+	}
+
+	sel, ok := n.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return nil
+	}
+
+	selection, ok := info.Selections[sel]
+	if !ok || selection.Kind() != types.MethodVal {
+		return nil
+	}
+
+	method, ok := selection.Obj().(*types.Func)
+	if !ok {
+		return nil
+	}
+
+	// The first index of a promoted method is the embedded field it is promoted through.
+	selected := -1
+	if len(selection.Index()) > 1 {
+		selected = selection.Index()[0]
+	}
+
+	var mutations []mutator.Mutation
+
+	for _, field := range embeddedCandidates(pkg, selection.Recv(), selected, method) {
+		original := sel.X
+		mutated := &ast.SelectorExpr{
+			X:   sel.X,
+			Sel: ast.NewIdent(field.Name()),
+		}
+
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				sel.X = mutated
+			},
+			Reset: func() {
+				sel.X = original
+			},
+		})
+	}
+
+	return mutations
+}
+
+// embeddedCandidates returns all accessible embedded fields of the given receiver type, except the field at the index "selected", which provide a method with the same name and signature as the given method.
+func embeddedCandidates(pkg *types.Package, recv types.Type, selected int, method *types.Func) []*types.Var {
+	if p, ok := recv.(*types.Pointer); ok {
+		recv = p.Elem()
+	}
+
+	if _, ok := recv.(*types.TypeParam); ok {
+		return nil
+	}
+
+	st, ok := recv.Underlying().(*types.Struct)
+	if !ok {
+		return nil
+	}
+
+	signature := method.Type().(*types.Signature)
+
+	var candidates []*types.Var
+
+	for i := 0; i < st.NumFields(); i++ {
+		field := st.Field(i)
+		if !field.Embedded() || i == selected {
+			continue
+		}
+		if !field.Exported() && field.Pkg() != pkg {
+			continue
+		}
+
+		obj, _, _ := types.LookupFieldOrMethod(field.Type(), true, pkg, method.Name())
+		other, ok := obj.(*types.Func)
+		if !ok {
+			continue
+		}
+
+		if !types.Identical(withoutRecv(other.Type().(*types.Signature)), withoutRecv(signature)) {
+			continue
+		}
+
+		candidates = append(candidates, field)
+	}
+
+	return candidates
+}
+
+// withoutRecv returns the given signature without its receiver so signatures of methods of different types can be compared.
+func withoutRecv(signature *types.Signature) *types.Signature {
+	return types.NewSignatureType(nil, nil, nil, signature.Params(), signature.Results(), signature.Variadic())
+}
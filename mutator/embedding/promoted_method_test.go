@@ -0,0 +1,16 @@
+package embedding
+
+import (
+	"testing"
+
+	"github.com/VirtualRoyalty/go-mutesting/test"
+)
+
+func TestMutatorPromotedMethod(t *testing.T) {
+	test.Mutator(
+		t,
+		MutatorPromotedMethod,
+		"../../testdata/embedding/promoted_method.go",
+		2,
+	)
+}
@@ -0,0 +1,104 @@
+package errors
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/VirtualRoyalty/go-mutesting/astutil"
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("errors/return_nil", MutatorReturnNil)
+}
+
+// MutatorReturnNil implements a mutator to drop errors.
+// Returned errors are replaced by nil and the branches of "if err != nil" are never taken.
+func MutatorReturnNil(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	if info == nil {
+		return nil
+	}
+
+	switch n := node.(type) {
+	case *ast.ReturnStmt:
+		return mutateReturnedErrors(info, n)
+	case *ast.IfStmt:
+		return mutateErrorCheck(info, n)
+	}
+
+	return nil
+}
+
+// mutateReturnedErrors replaces every returned error which is not nil by nil.
+// Errors which hold the only usage of a local variable or an imported package are kept, e.g. the err of "_, err := f(); return err".
+func mutateReturnedErrors(info *types.Info, n *ast.ReturnStmt) []mutator.Mutation {
+	var mutations []mutator.Mutation
+
+	for i, result := range n.Results {
+		tv, ok := info.Types[result]
+		if !ok || tv.IsNil() || !isError(tv.Type) || !astutil.RemovableExpr(info, result) {
+			continue
+		}
+
+		i := i
+		original := result
+
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				n.Results[i] = ast.NewIdent("nil")
+			},
+			Reset: func() {
+				n.Results[i] = original
+			},
+		})
+	}
+
+	return mutations
+}
+
+// mutateErrorCheck makes the condition of "if err != nil" false, so the handling of the error is skipped.
+func mutateErrorCheck(info *types.Info, n *ast.IfStmt) []mutator.Mutation {
+	cond, ok := n.Cond.(*ast.BinaryExpr)
+	if !ok || cond.Op != token.NEQ {
+		return nil
+	}
+
+	checked := cond.X
+	if isNil(info, checked) {
+		checked = cond.Y
+	} else if !isNil(info, cond.Y) {
+		return nil
+	}
+	if !isError(info.TypeOf(checked)) {
+		return nil
+	}
+
+	// The error is still used by the condition since it might not be used anywhere else
+	mutated := &ast.BinaryExpr{
+		X:  ast.NewIdent("false"),
+		Op: token.LAND,
+		Y:  cond,
+	}
+
+	return []mutator.Mutation{
+		{
+			Change: func() {
+				n.Cond = mutated
+			},
+			Reset: func() {
+				n.Cond = cond
+			},
+		},
+	}
+}
+
+func isNil(info *types.Info, expr ast.Expr) bool {
+	tv, ok := info.Types[expr]
+
+	return ok && tv.IsNil()
+}
+
+func isError(t types.Type) bool {
+	return t != nil && types.Identical(t, types.Universe.Lookup("error").Type())
+}
@@ -0,0 +1,16 @@
+package errors
+
+import (
+	"testing"
+
+	"github.com/VirtualRoyalty/go-mutesting/test"
+)
+
+func TestMutatorReturnNil(t *testing.T) {
+	test.Mutator(
+		t,
+		MutatorReturnNil,
+		"../../testdata/errors/return_nil.go",
+		5,
+	)
+}
@@ -0,0 +1,210 @@
+package errors
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"github.com/VirtualRoyalty/go-mutesting/astutil"
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.RegisterConfigurable("errors/wrap", NewMutatorWrap)
+}
+
+// defaultWrapFunctions are the wrapping functions which are removed if the "functions" parameter is not set.
+var defaultWrapFunctions = []string{
+	"github.com/pkg/errors.Wrap",
+	"github.com/pkg/errors.Wrapf",
+	"github.com/pkg/errors.WithMessage",
+	"github.com/pkg/errors.WithMessagef",
+	"github.com/pkg/errors.WithStack",
+}
+
+var defaultWrap = newWrapMutator(wrapFunctionSet(defaultWrapFunctions))
+
+// MutatorWrap implements a mutator to degrade the wrapping of errors, which checks whether the tests assert on the identity of errors with errors.Is and errors.As.
+// The %w verbs of fmt.Errorf are replaced by %v, fmt.Errorf("...: %w", err) is replaced by err and calls of wrapping functions like errors.Wrap(err, "...") are replaced by err.
+func MutatorWrap(pkg *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	return defaultWrap(pkg, info, node)
+}
+
+// NewMutatorWrap returns a mutator to degrade the wrapping of errors which removes the wrapping functions of the "functions" parameter,
+// which are given by their import path and name, e.g. "github.com/pkg/errors.Wrap".
+func NewMutatorWrap(config mutator.Config) (mutator.Mutator, error) {
+	err := config.Check("functions")
+	if err != nil {
+		return nil, err
+	}
+
+	functions, err := config.Strings("functions", defaultWrapFunctions)
+	if err != nil {
+		return nil, err
+	}
+	for _, function := range functions {
+		if i := strings.LastIndex(function, "."); i <= 0 || i == len(function)-1 {
+			return nil, fmt.Errorf("function %q is not given by its import path and name, e.g. \"github.com/pkg/errors.Wrap\"", function)
+		}
+	}
+
+	return newWrapMutator(wrapFunctionSet(functions)), nil
+}
+
+func wrapFunctionSet(functions []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(functions))
+	for _, function := range functions {
+		set[function] = struct{}{}
+	}
+
+	return set
+}
+
+func newWrapMutator(functions map[string]struct{}) mutator.Mutator {
+	return func(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+		if info == nil {
+			return nil
+		}
+
+		var mutations []mutator.Mutation
+
+		if call, ok := node.(*ast.CallExpr); ok && isFunction(info, call, "fmt.Errorf") {
+			mutations = append(mutations, mutateWrapVerbs(call)...)
+		}
+
+		// Wrapped errors are unwrapped in the parent of the call
+		for _, x := range astutil.ValueExprs(node) {
+			if mutation, ok := mutateWrapCall(functions, info, x); ok {
+				mutations = append(mutations, mutation)
+			}
+		}
+
+		return mutations
+	}
+}
+
+// mutateWrapVerbs replaces every %w verb of the literal format of fmt.Errorf on its own by %v.
+func mutateWrapVerbs(call *ast.CallExpr) []mutator.Mutation {
+	if len(call.Args) == 0 {
+		return nil
+	}
+
+	lit, ok := call.Args[0].(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return nil
+	}
+
+	var mutations []mutator.Mutation
+
+	original := lit.Value
+	for i := 0; i < len(original)-1; i++ {
+		if original[i] != '%' {
+			continue
+		} else if original[i+1] != 'w' {
+			// Skip the verb, e.g. of an escaped percent sign
+			i++
+
+			continue
+		}
+
+		mutated := original[:i+1] + "v" + original[i+2:]
+
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				lit.Value = mutated
+			},
+			Reset: func() {
+				lit.Value = original
+			},
+		})
+	}
+
+	return mutations
+}
+
+// mutateWrapCall replaces the expression by the wrapped error if it is a call of fmt.Errorf with a format which only wraps the error, or of a wrapping function.
+// The other arguments of a wrapping function have to be literals, so no variable loses its only usage, and the package of the function has to be still used after the mutation.
+func mutateWrapCall(functions map[string]struct{}, info *types.Info, x *ast.Expr) (mutator.Mutation, bool) {
+	call, ok := (*x).(*ast.CallExpr)
+	if !ok || len(call.Args) < 2 || call.Ellipsis.IsValid() {
+		return mutator.Mutation{}, false
+	}
+
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return mutator.Mutation{}, false
+	}
+
+	path := astutil.PackagePath(info, sel.X)
+	if path == "" {
+		return mutator.Mutation{}, false
+	}
+
+	var wrapped ast.Expr
+
+	if function := path + "." + sel.Sel.Name; function == "fmt.Errorf" {
+		if len(call.Args) != 2 || !onlyWraps(call.Args[0]) {
+			return mutator.Mutation{}, false
+		}
+
+		wrapped = call.Args[1]
+	} else if _, ok := functions[function]; ok {
+		for _, arg := range call.Args[1:] {
+			if _, ok := arg.(*ast.BasicLit); !ok {
+				return mutator.Mutation{}, false
+			}
+		}
+
+		wrapped = call.Args[0]
+	} else {
+		return mutator.Mutation{}, false
+	}
+
+	if !isError(info.TypeOf(wrapped)) || !isError(info.TypeOf(call)) {
+		return mutator.Mutation{}, false
+	}
+	if !astutil.PackageUsedElsewhere(info, info.Uses[sel.X.(*ast.Ident)].(*types.PkgName)) {
+		return mutator.Mutation{}, false
+	}
+
+	original := *x
+
+	return mutator.Mutation{
+		Change: func() {
+			*x = wrapped
+		},
+		Reset: func() {
+			*x = original
+		},
+	}, true
+}
+
+// onlyWraps returns true if the format is a literal whose only verb is %w.
+func onlyWraps(format ast.Expr) bool {
+	lit, ok := format.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return false
+	}
+
+	value, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return false
+	}
+
+	return strings.Count(value, "%") == 1 && strings.Count(value, "%w") == 1
+}
+
+// isFunction returns true if the call calls the function which is given by its import path and name.
+func isFunction(info *types.Info, call *ast.CallExpr, function string) bool {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+
+	path := astutil.PackagePath(info, sel.X)
+
+	return path != "" && path+"."+sel.Sel.Name == function
+}
@@ -0,0 +1,40 @@
+package errors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/VirtualRoyalty/go-mutesting"
+	"github.com/VirtualRoyalty/go-mutesting/internal/parser"
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+	"github.com/VirtualRoyalty/go-mutesting/test"
+)
+
+func TestMutatorWrap(t *testing.T) {
+	test.Mutator(
+		t,
+		MutatorWrap,
+		"../../testdata/errors/wrap.go",
+		4,
+	)
+}
+
+func TestNewMutatorWrap(t *testing.T) {
+	src, _, pkg, info, err := parser.ParseAndTypeCheckFile("../../testdata/errors/wrap.go", "", nil)
+	assert.NoError(t, err)
+
+	for count, functions := range map[int][]interface{}{
+		4: {"github.com/pkg/errors.Wrap"},
+		3: {},
+	} {
+		m, err := NewMutatorWrap(mutator.Config{"functions": functions})
+		assert.NoError(t, err)
+		assert.Equal(t, count, mutesting.CountWalk(pkg, info, src, m), functions)
+	}
+
+	for _, config := range []mutator.Config{{"functions": "github.com/pkg/errors.Wrap"}, {"functions": []string{"Wrap"}}, {"function": []string{"github.com/pkg/errors.Wrap"}}} {
+		_, err := NewMutatorWrap(config)
+		assert.Error(t, err, config)
+	}
+}
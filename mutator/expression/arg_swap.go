@@ -0,0 +1,71 @@
+package expression
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("expression/arg_swap", MutatorArgSwap)
+}
+
+// MutatorArgSwap implements a mutator to swap adjacent arguments of calls which have identical types, e.g. min(a, b) is replaced by min(b, a).
+// Conversions, spread arguments and arguments which are the same expression are not swapped.
+// The length and capacity of the make builtin are not swapped either since a constant length must not exceed the capacity.
+func MutatorArgSwap(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	if info == nil {
+		return nil
+	}
+
+	n, ok := node.(*ast.CallExpr)
+	if !ok {
+		return nil
+	} else if tv, ok := info.Types[n.Fun]; ok && tv.IsType() {
+		return nil
+	} else if isBuiltin(info, n.Fun, "make") {
+		return nil
+	}
+
+	last := len(n.Args) - 1
+	if n.Ellipsis.IsValid() {
+		last--
+	}
+
+	var mutations []mutator.Mutation
+
+	for i := 0; i < last; i++ {
+		a, b := n.Args[i], n.Args[i+1]
+
+		ta, tb := info.TypeOf(a), info.TypeOf(b)
+		if ta == nil || tb == nil || !types.Identical(ta, tb) || types.ExprString(a) == types.ExprString(b) {
+			continue
+		}
+
+		i := i
+
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				n.Args[i], n.Args[i+1] = b, a
+			},
+			Reset: func() {
+				n.Args[i], n.Args[i+1] = a, b
+			},
+		})
+	}
+
+	return mutations
+}
+
+// isBuiltin checks if the expression is the builtin function with the given name.
+func isBuiltin(info *types.Info, fun ast.Expr, name string) bool {
+	id, ok := ast.Unparen(fun).(*ast.Ident)
+	if !ok {
+		return false
+	}
+
+	builtin, ok := info.Uses[id].(*types.Builtin)
+
+	return ok && builtin.Name() == name
+}
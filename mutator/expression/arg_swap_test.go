@@ -0,0 +1,16 @@
+package expression
+
+import (
+	"testing"
+
+	"github.com/VirtualRoyalty/go-mutesting/test"
+)
+
+func TestMutatorArgSwap(t *testing.T) {
+	test.Mutator(
+		t,
+		MutatorArgSwap,
+		"../../testdata/expression/arg_swap.go",
+		3,
+	)
+}
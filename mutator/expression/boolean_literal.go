@@ -0,0 +1,51 @@
+package expression
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("expression/boolean_literal", MutatorBooleanLiteral)
+}
+
+var booleanLiteralMutations = map[string]string{
+	"true":  "false",
+	"false": "true",
+}
+
+// MutatorBooleanLiteral implements a mutator to flip the boolean constants true and false.
+func MutatorBooleanLiteral(pkg *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	if info == nil {
+		return nil
+	}
+
+	n, ok := node.(*ast.Ident)
+	if !ok {
+		return nil
+	}
+
+	original := n.Name
+	mutated, ok := booleanLiteralMutations[original]
+	if !ok {
+		return nil
+	}
+
+	// Identifiers which shadow the predeclared constants are not flipped
+	if info.Uses[n] != types.Universe.Lookup(original) {
+		return nil
+	}
+
+	return []mutator.Mutation{
+		{
+			Change: func() {
+				n.Name = mutated
+			},
+			Reset: func() {
+				n.Name = original
+			},
+		},
+	}
+}
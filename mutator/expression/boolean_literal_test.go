@@ -0,0 +1,16 @@
+package expression
+
+import (
+	"testing"
+
+	"github.com/VirtualRoyalty/go-mutesting/test"
+)
+
+func TestMutatorBooleanLiteral(t *testing.T) {
+	test.Mutator(
+		t,
+		MutatorBooleanLiteral,
+		"../../testdata/expression/boolean_literal.go",
+		3,
+	)
+}
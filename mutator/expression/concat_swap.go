@@ -0,0 +1,54 @@
+package expression
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("expression/concat_swap", MutatorConcatSwap)
+}
+
+// MutatorConcatSwap implements a mutator to swap the operands of string concatenations, e.g. a + b is replaced by b + a.
+// Operands which are the same expression are not swapped.
+func MutatorConcatSwap(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	if info == nil {
+		return nil
+	}
+
+	n, ok := node.(*ast.BinaryExpr)
+	if !ok || n.Op != token.ADD {
+		return nil
+	}
+
+	if !isString(info.TypeOf(n.X)) || !isString(info.TypeOf(n.Y)) || types.ExprString(n.X) == types.ExprString(n.Y) {
+		return nil
+	}
+
+	x, y := n.X, n.Y
+
+	return []mutator.Mutation{
+		{
+			Change: func() {
+				n.X, n.Y = y, x
+			},
+			Reset: func() {
+				n.X, n.Y = x, y
+			},
+		},
+	}
+}
+
+// isString returns true if the type is a string type, including untyped string constants.
+func isString(t types.Type) bool {
+	if t == nil {
+		return false
+	}
+
+	basic, ok := t.Underlying().(*types.Basic)
+
+	return ok && basic.Info()&types.IsString != 0
+}
@@ -0,0 +1,16 @@
+package expression
+
+import (
+	"testing"
+
+	"github.com/VirtualRoyalty/go-mutesting/test"
+)
+
+func TestMutatorConcatSwap(t *testing.T) {
+	test.Mutator(
+		t,
+		MutatorConcatSwap,
+		"../../testdata/expression/concat_swap.go",
+		3,
+	)
+}
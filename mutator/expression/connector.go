@@ -0,0 +1,43 @@
+package expression
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("expression/connector", MutatorConnector)
+}
+
+var connectorMutations = map[token.Token]token.Token{
+	token.LAND: token.LOR,
+	token.LOR:  token.LAND,
+}
+
+// MutatorConnector implements a mutator to swap the logical connectors && and ||.
+func MutatorConnector(pkg *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	n, ok := node.(*ast.BinaryExpr)
+	if !ok {
+		return nil
+	}
+
+	o := n.Op
+	r, ok := connectorMutations[n.Op]
+	if !ok {
+		return nil
+	}
+
+	return []mutator.Mutation{
+		{
+			Change: func() {
+				n.Op = r
+			},
+			Reset: func() {
+				n.Op = o
+			},
+		},
+	}
+}
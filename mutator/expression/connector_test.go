@@ -0,0 +1,16 @@
+package expression
+
+import (
+	"testing"
+
+	"github.com/VirtualRoyalty/go-mutesting/test"
+)
+
+func TestMutatorConnector(t *testing.T) {
+	test.Mutator(
+		t,
+		MutatorConnector,
+		"../../testdata/expression/connector.go",
+		3,
+	)
+}
@@ -0,0 +1,117 @@
+package expression
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.RegisterOptIn("expression/index", MutatorIndex)
+}
+
+// MutatorIndex implements a mutator to shift the index of index expressions by one.
+// Only slices, arrays and strings are mutated and constant indices are kept in bounds if the bounds are known at compile time.
+func MutatorIndex(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	if info == nil {
+		return nil
+	}
+
+	n, ok := node.(*ast.IndexExpr)
+	if !ok {
+		return nil
+	}
+
+	length, ok := indexableLength(info, n.X)
+	if !ok {
+		return nil
+	}
+
+	index, ok := info.Types[n.Index]
+	if !ok {
+		return nil
+	}
+	if basic, ok := index.Type.Underlying().(*types.Basic); !ok || basic.Info()&types.IsInteger == 0 {
+		return nil
+	}
+
+	decrement, increment := true, true
+	if index.Value != nil {
+		value, exact := constant.Int64Val(index.Value)
+		if !exact {
+			return nil
+		}
+
+		decrement = value > 0
+		increment = length < 0 || value+1 < length
+	}
+
+	original := n.Index
+
+	var mutations []mutator.Mutation
+	for _, op := range []token.Token{token.SUB, token.ADD} {
+		if (op == token.SUB && !decrement) || (op == token.ADD && !increment) {
+			continue
+		}
+
+		mutated := &ast.BinaryExpr{
+			X:  original,
+			Op: op,
+			Y: &ast.BasicLit{
+				Kind:  token.INT,
+				Value: "1",
+			},
+		}
+
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				n.Index = mutated
+			},
+			Reset: func() {
+				n.Index = original
+			},
+		})
+	}
+
+	return mutations
+}
+
+// indexableLength checks if the expression is a slice, an array or a string and returns its length if it is known at compile time, otherwise -1.
+func indexableLength(info *types.Info, x ast.Expr) (int64, bool) {
+	tv, ok := info.Types[x]
+	if !ok || !tv.IsValue() {
+		return 0, false
+	}
+
+	typ := tv.Type.Underlying()
+	if p, ok := typ.(*types.Pointer); ok {
+		array, ok := p.Elem().Underlying().(*types.Array)
+		if !ok {
+			return 0, false
+		}
+
+		return array.Len(), true
+	}
+
+	switch t := typ.(type) {
+	case *types.Slice:
+		return -1, true
+	case *types.Array:
+		return t.Len(), true
+	case *types.Basic:
+		if t.Info()&types.IsString == 0 {
+			return 0, false
+		}
+
+		if tv.Value != nil {
+			return int64(len(constant.StringVal(tv.Value))), true
+		}
+
+		return -1, true
+	}
+
+	return 0, false
+}
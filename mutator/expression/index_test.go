@@ -0,0 +1,16 @@
+package expression
+
+import (
+	"testing"
+
+	"github.com/VirtualRoyalty/go-mutesting/test"
+)
+
+func TestMutatorIndex(t *testing.T) {
+	test.Mutator(
+		t,
+		MutatorIndex,
+		"../../testdata/expression/index.go",
+		8,
+	)
+}
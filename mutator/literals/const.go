@@ -0,0 +1,195 @@
+package literals
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("literals/const", MutatorConst)
+}
+
+// intBits are the sizes of the integer types whose constants can overflow if they are incremented.
+var intBits = map[types.BasicKind]uint{
+	types.Int:     64,
+	types.Int8:    8,
+	types.Int16:   16,
+	types.Int32:   32,
+	types.Int64:   64,
+	types.Uint:    64,
+	types.Uint8:   8,
+	types.Uint16:  16,
+	types.Uint32:  32,
+	types.Uint64:  64,
+	types.Uintptr: 64,
+}
+
+// MutatorConst implements a mutator for the values of const declarations, numeric values are incremented and boolean values are negated.
+// Values which use iota are not mutated as well as single literals, which the numbers and expression mutators already mutate.
+func MutatorConst(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	if info == nil {
+		return nil
+	}
+
+	n, ok := node.(*ast.GenDecl)
+	if !ok || n.Tok != token.CONST {
+		return nil
+	}
+
+	var mutations []mutator.Mutation
+
+	for _, spec := range n.Specs {
+		s, ok := spec.(*ast.ValueSpec)
+		if !ok {
+			continue
+		}
+
+		for i, value := range s.Values {
+			if i >= len(s.Names) {
+				break
+			}
+
+			mutated := mutateConst(info, s.Names[i], value)
+			if mutated == nil {
+				continue
+			}
+
+			x := &s.Values[i]
+			original := *x
+
+			mutations = append(mutations, mutator.Mutation{
+				Change: func() {
+					*x = mutated
+				},
+				Reset: func() {
+					*x = original
+				},
+				Pos: original.Pos(),
+			})
+		}
+	}
+
+	return mutations
+}
+
+// mutateConst returns the mutated value of a constant or nil if it is not mutated.
+func mutateConst(info *types.Info, name *ast.Ident, value ast.Expr) ast.Expr {
+	tv, ok := info.Types[value]
+	if !ok || tv.Value == nil || usesIota(info, value) {
+		return nil
+	}
+
+	switch v := value.(type) {
+	case *ast.BasicLit:
+		return nil
+	case *ast.Ident:
+		if info.Uses[v] == types.Universe.Lookup(v.Name) {
+			return nil
+		}
+	}
+
+	switch tv.Value.Kind() {
+	case constant.Bool:
+		return &ast.UnaryExpr{
+			Op: token.NOT,
+			X:  parenthesize(value),
+		}
+	case constant.Int, constant.Float:
+		if !incrementable(tv.Type, tv.Value) {
+			return nil
+		}
+
+		// Untyped constants get their types by their uses
+		if isUntyped(tv.Type) && !usesIncrementable(info, info.Defs[name], tv.Value) {
+			return nil
+		}
+
+		return &ast.BinaryExpr{
+			X:  value,
+			Op: token.ADD,
+			Y: &ast.BasicLit{
+				Kind:  token.INT,
+				Value: "1",
+			},
+		}
+	}
+
+	return nil
+}
+
+// incrementable returns true if the incremented constant still fits into its type.
+func incrementable(t types.Type, value constant.Value) bool {
+	basic, ok := t.Underlying().(*types.Basic)
+	if !ok || basic.Info()&types.IsNumeric == 0 {
+		return false
+	}
+
+	bits, ok := intBits[basic.Kind()]
+	if !ok {
+		return true
+	}
+
+	if basic.Info()&types.IsUnsigned == 0 {
+		bits--
+	}
+	max := constant.Shift(constant.MakeInt64(1), token.SHL, bits)
+
+	return constant.Compare(constant.BinaryOp(value, token.ADD, constant.MakeInt64(1)), token.LSS, max)
+}
+
+// usesIncrementable returns true if the incremented constant still fits into the types of all uses of the constant.
+// Uses in other constant expressions are untyped and can not be checked, so they are not incrementable.
+func usesIncrementable(info *types.Info, obj types.Object, value constant.Value) bool {
+	if obj == nil {
+		return false
+	}
+
+	for id, used := range info.Uses {
+		if used != obj {
+			continue
+		}
+
+		tv, ok := info.Types[id]
+		if !ok || isUntyped(tv.Type) || !incrementable(tv.Type, value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isUntyped(t types.Type) bool {
+	basic, ok := t.(*types.Basic)
+
+	return ok && basic.Info()&types.IsUntyped != 0
+}
+
+// usesIota returns true if the expression uses the predeclared iota.
+func usesIota(info *types.Info, x ast.Expr) bool {
+	found := false
+
+	ast.Inspect(x, func(node ast.Node) bool {
+		if id, ok := node.(*ast.Ident); ok && info.Uses[id] == types.Universe.Lookup("iota") {
+			found = true
+		}
+
+		return !found
+	})
+
+	return found
+}
+
+// parenthesize wraps binary expressions in parentheses, so they can be the operand of a unary expression.
+func parenthesize(x ast.Expr) ast.Expr {
+	if _, ok := x.(*ast.BinaryExpr); ok {
+		return &ast.ParenExpr{
+			X: x,
+		}
+	}
+
+	return x
+}
@@ -0,0 +1,16 @@
+package literals
+
+import (
+	"testing"
+
+	"github.com/VirtualRoyalty/go-mutesting/test"
+)
+
+func TestMutatorConst(t *testing.T) {
+	test.Mutator(
+		t,
+		MutatorConst,
+		"../../testdata/literals/const.go",
+		6,
+	)
+}
@@ -0,0 +1,71 @@
+package literals
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strconv"
+
+	"github.com/VirtualRoyalty/go-mutesting/astutil"
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("literals/string", MutatorString)
+}
+
+// stringSentinel is the value which replaces empty string literals.
+const stringSentinel = "go-mutesting"
+
+// MutatorString implements a mutator to replace non-empty string literals with an empty string and empty ones with a sentinel.
+// The literals are replaced in their parents, so case expressions and map keys which must not be duplicated are not mutated.
+// Values of constants are not mutated as well since constants can be case expressions too.
+func MutatorString(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	if info == nil {
+		return nil
+	}
+
+	switch n := node.(type) {
+	case *ast.ValueSpec:
+		if len(n.Names) != 0 {
+			if _, ok := info.Defs[n.Names[0]].(*types.Const); ok {
+				return nil
+			}
+		}
+	case ast.Expr:
+		if tv, ok := info.Types[n]; ok && tv.Value != nil {
+			return nil
+		}
+	}
+
+	var mutations []mutator.Mutation
+	for _, x := range astutil.ValueExprs(node) {
+		n, ok := (*x).(*ast.BasicLit)
+		if !ok || n.Kind != token.STRING {
+			continue
+		}
+
+		value, err := strconv.Unquote(n.Value)
+		if err != nil {
+			continue
+		}
+
+		original := n.Value
+		mutated := `""`
+		if value == "" {
+			mutated = strconv.Quote(stringSentinel)
+		}
+
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				n.Value = mutated
+			},
+			Reset: func() {
+				n.Value = original
+			},
+			Pos: n.Pos(),
+		})
+	}
+
+	return mutations
+}
@@ -0,0 +1,16 @@
+package literals
+
+import (
+	"testing"
+
+	"github.com/VirtualRoyalty/go-mutesting/test"
+)
+
+func TestMutatorString(t *testing.T) {
+	test.Mutator(
+		t,
+		MutatorString,
+		"../../testdata/literals/string.go",
+		5,
+	)
+}
@@ -0,0 +1,101 @@
+package loop
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("loop/branch_swap", MutatorLoopBranchSwap)
+}
+
+// MutatorLoopBranchSwap implements a mutator to swap the break and continue statements of loops.
+// Unlike loop/break only branch statements which target the visited loop or labeled loop are changed, e.g. a break of a switch statement stays a break.
+func MutatorLoopBranchSwap(_ *types.Package, _ *types.Info, node ast.Node) []mutator.Mutation {
+	label := ""
+	if n, ok := node.(*ast.LabeledStmt); ok {
+		label = n.Label.Name
+		node = n.Stmt
+	}
+
+	var body *ast.BlockStmt
+
+	switch n := node.(type) {
+	case *ast.ForStmt:
+		body = n.Body
+	case *ast.RangeStmt:
+		body = n.Body
+	default:
+		return nil
+	}
+
+	var branches []*ast.BranchStmt
+	loopBranches(body, label, true, &branches)
+
+	mutations := make([]mutator.Mutation, len(branches))
+	for i, n := range branches {
+		n := n
+		original := n.Tok
+		mutated := breakMutations[n.Tok]
+
+		mutations[i] = mutator.Mutation{
+			Change: func() {
+				n.Tok = mutated
+			},
+			Reset: func() {
+				n.Tok = original
+			},
+			Pos: n.Pos(),
+		}
+	}
+
+	return mutations
+}
+
+// loopBranches collects the break and continue statements of the loop body which target the loop.
+// Without a label these are the branch statements outside of nested loops, and breaks only outside of nested switch and select statements.
+func loopBranches(body ast.Node, label string, breaks bool, branches *[]*ast.BranchStmt) {
+	ast.Inspect(body, func(node ast.Node) bool {
+		switch n := node.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.ForStmt, *ast.RangeStmt:
+			return label != ""
+		case *ast.SwitchStmt:
+			if label == "" && breaks {
+				loopBranches(n.Body, label, false, branches)
+
+				return false
+			}
+		case *ast.TypeSwitchStmt:
+			if label == "" && breaks {
+				loopBranches(n.Body, label, false, branches)
+
+				return false
+			}
+		case *ast.SelectStmt:
+			if label == "" && breaks {
+				loopBranches(n.Body, label, false, branches)
+
+				return false
+			}
+		case *ast.BranchStmt:
+			if _, ok := breakMutations[n.Tok]; !ok {
+				return false
+			}
+
+			if label == "" {
+				if n.Label == nil && (breaks || n.Tok == token.CONTINUE) {
+					*branches = append(*branches, n)
+				}
+			} else if n.Label != nil && n.Label.Name == label {
+				*branches = append(*branches, n)
+			}
+		}
+
+		return true
+	})
+}
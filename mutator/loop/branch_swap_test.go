@@ -0,0 +1,16 @@
+package loop
+
+import (
+	"testing"
+
+	"github.com/VirtualRoyalty/go-mutesting/test"
+)
+
+func TestMutatorLoopBranchSwap(t *testing.T) {
+	test.Mutator(
+		t,
+		MutatorLoopBranchSwap,
+		"../../testdata/loop/branch_swap.go",
+		5,
+	)
+}
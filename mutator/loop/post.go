@@ -0,0 +1,33 @@
+package loop
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("loop/post", MutatorLoopPost)
+}
+
+// MutatorLoopPost implements a mutator to remove the post statement of for loops, so the loop makes no progress.
+func MutatorLoopPost(_ *types.Package, _ *types.Info, node ast.Node) []mutator.Mutation {
+	n, ok := node.(*ast.ForStmt)
+	if !ok || n.Post == nil {
+		return nil
+	}
+
+	original := n.Post
+
+	return []mutator.Mutation{
+		{
+			Change: func() {
+				n.Post = nil
+			},
+			Reset: func() {
+				n.Post = original
+			},
+		},
+	}
+}
@@ -0,0 +1,16 @@
+package loop
+
+import (
+	"testing"
+
+	"github.com/VirtualRoyalty/go-mutesting/test"
+)
+
+func TestMutatorLoopPost(t *testing.T) {
+	test.Mutator(
+		t,
+		MutatorLoopPost,
+		"../../testdata/loop/post.go",
+		2,
+	)
+}
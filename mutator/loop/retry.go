@@ -0,0 +1,104 @@
+package loop
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"regexp"
+
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("loop/retry", MutatorLoopRetry)
+}
+
+// retryNameRegex matches names of retry counters and their bounds, e.g. attempt, maxRetries and tries.
+var retryNameRegex = regexp.MustCompile(`(?i:attempt|retr(?:y|ies))|^(?i:tr(?:y|ies))|Tr(?:y|ies)`)
+
+// MutatorLoopRetry implements a mutator to change the constant bound of retry loops to 1 and 0.
+// A loop is a retry loop if its condition compares a counter with a constant integer and either of them is named like a retry counter.
+func MutatorLoopRetry(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	if info == nil {
+		return nil
+	}
+
+	n, ok := node.(*ast.ForStmt)
+	if !ok {
+		return nil
+	}
+
+	condition, ok := n.Cond.(*ast.BinaryExpr)
+	if !ok {
+		return nil
+	}
+
+	switch condition.Op {
+	case token.LSS, token.LEQ, token.GTR, token.GEQ, token.NEQ:
+	default:
+		return nil
+	}
+
+	bound := &condition.Y
+	counter := condition.X
+	if isIntegerConstant(info, condition.X) {
+		bound = &condition.X
+		counter = condition.Y
+	}
+
+	if !isIntegerConstant(info, *bound) || isIntegerConstant(info, counter) {
+		return nil
+	}
+
+	if !retryNameRegex.MatchString(exprName(counter)) && !retryNameRegex.MatchString(exprName(*bound)) {
+		return nil
+	}
+
+	original := *bound
+	value := info.Types[original].Value
+
+	var mutations []mutator.Mutation
+	for _, v := range []int64{1, 0} {
+		if constant.Compare(value, token.EQL, constant.MakeInt64(v)) {
+			continue
+		}
+
+		mutated := &ast.BasicLit{
+			Kind:  token.INT,
+			Value: constant.MakeInt64(v).String(),
+		}
+
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				*bound = mutated
+			},
+			Reset: func() {
+				*bound = original
+			},
+		})
+	}
+
+	return mutations
+}
+
+func isIntegerConstant(info *types.Info, x ast.Expr) bool {
+	tv, ok := info.Types[x]
+	if !ok || tv.Value == nil {
+		return false
+	}
+
+	return tv.Value.Kind() == constant.Int
+}
+
+// exprName returns the name of an identifier or of the selected field of a selector, otherwise an empty string.
+func exprName(x ast.Expr) string {
+	switch e := x.(type) {
+	case *ast.Ident:
+		return e.Name
+	case *ast.SelectorExpr:
+		return e.Sel.Name
+	}
+
+	return ""
+}
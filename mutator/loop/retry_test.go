@@ -0,0 +1,16 @@
+package loop
+
+import (
+	"testing"
+
+	"github.com/VirtualRoyalty/go-mutesting/test"
+)
+
+func TestMutatorLoopRetry(t *testing.T) {
+	test.Mutator(
+		t,
+		MutatorLoopRetry,
+		"../../testdata/loop/retry.go",
+		5,
+	)
+}
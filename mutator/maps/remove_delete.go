@@ -0,0 +1,76 @@
+package maps
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/VirtualRoyalty/go-mutesting/astutil"
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("maps/remove_delete", MutatorRemoveDelete)
+}
+
+// MutatorRemoveDelete implements a mutator to remove calls of the delete builtin.
+// The call is replaced by an assignment of its arguments to blank identifiers, so the map and the key stay used.
+func MutatorRemoveDelete(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	if info == nil {
+		return nil
+	}
+
+	var l []ast.Stmt
+
+	switch n := node.(type) {
+	case *ast.BlockStmt:
+		l = n.List
+	case *ast.CaseClause:
+		l = n.Body
+	case *ast.CommClause:
+		l = n.Body
+	}
+
+	var mutations []mutator.Mutation
+
+	for i, stmt := range l {
+		if !isDelete(info, stmt) {
+			continue
+		}
+
+		li := i
+		old := l[li]
+		noop := astutil.CreateNoopOfExprs(info, old.(*ast.ExprStmt).X.(*ast.CallExpr).Args)
+
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				l[li] = noop
+			},
+			Reset: func() {
+				l[li] = old
+			},
+			Pos: old.Pos(),
+		})
+	}
+
+	return mutations
+}
+
+// isDelete returns true if the statement is a call of the delete builtin.
+func isDelete(info *types.Info, stmt ast.Stmt) bool {
+	e, ok := stmt.(*ast.ExprStmt)
+	if !ok {
+		return false
+	}
+	call, ok := e.X.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	id, ok := call.Fun.(*ast.Ident)
+	if !ok {
+		return false
+	}
+
+	builtin, ok := info.Uses[id].(*types.Builtin)
+
+	return ok && builtin.Name() == "delete"
+}
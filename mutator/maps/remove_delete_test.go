@@ -0,0 +1,16 @@
+package maps
+
+import (
+	"testing"
+
+	"github.com/VirtualRoyalty/go-mutesting/test"
+)
+
+func TestMutatorRemoveDelete(t *testing.T) {
+	test.Mutator(
+		t,
+		MutatorRemoveDelete,
+		"../../testdata/maps/remove_delete.go",
+		3,
+	)
+}
@@ -1,9 +1,15 @@
 package mutator
 
+import (
+	"go/token"
+)
+
 // Mutation defines the behavior of one mutation
 type Mutation struct {
 	// Change is called before executing the exec command.
 	Change func()
 	// Reset is called after executing the exec command.
 	Reset func()
+	// Pos is the position of the mutated code if it is not the node which is handed to the mutator, e.g. a statement of a mutated block.
+	Pos token.Pos
 }
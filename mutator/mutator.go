@@ -11,6 +11,7 @@ import (
 type Mutator func(pkg *types.Package, info *types.Info, node ast.Node) []Mutation
 
 var mutatorLookup = make(map[string]Mutator)
+var optInLookup = make(map[string]bool)
 
 // New returns a new mutator instance given the registered name of the mutator.
 // The error return argument is not nil, if the name does not exist in the registered mutator list.
@@ -48,3 +49,15 @@ func Register(name string, mutator Mutator) {
 
 	mutatorLookup[name] = mutator
 }
+
+// RegisterOptIn registers a mutator instance function with the given name which is disabled by default and has to be enabled explicitly.
+func RegisterOptIn(name string, mutator Mutator) {
+	Register(name, mutator)
+
+	optInLookup[name] = true
+}
+
+// IsOptIn returns true if the mutator with the given name is disabled by default.
+func IsOptIn(name string) bool {
+	return optInLookup[name]
+}
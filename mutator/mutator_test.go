@@ -70,3 +70,16 @@ func TestMockMutator(t *testing.T) {
 	}()
 	assert.True(t, caught)
 }
+
+func TestOptInMutator(t *testing.T) {
+	Register("mock-default", mockMutator)
+	RegisterOptIn("mock-opt-in", mockMutator)
+
+	assert.False(t, IsOptIn("mock-default"))
+	assert.True(t, IsOptIn("mock-opt-in"))
+	assert.False(t, IsOptIn("mock-unknown"))
+
+	m, err := New("mock-opt-in")
+	assert.NotNil(t, m)
+	assert.Nil(t, err)
+}
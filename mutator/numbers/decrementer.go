@@ -2,67 +2,28 @@ package numbers
 
 import (
 	"go/ast"
-	"go/token"
 	"go/types"
-	"strconv"
 
 	"github.com/VirtualRoyalty/go-mutesting/mutator"
 )
 
 func init() {
-	mutator.Register("numbers/decrementer", MutatorNumbersDecrementer)
+	mutator.RegisterConfigurable("numbers/decrementer", NewMutatorNumbersDecrementer)
 }
 
 // MutatorNumbersDecrementer implements a mutator to decrement int and float.
 func MutatorNumbersDecrementer(_ *types.Package, _ *types.Info, node ast.Node) []mutator.Mutation {
-	n, ok := node.(*ast.BasicLit)
-	if !ok {
-		return nil
-	}
-
-	if n.Kind == token.INT {
-		original := n.Value
-		originalInt, err := strconv.Atoi(n.Value)
-		if err != nil {
-			return nil
-		}
-
-		originalInt--
-		mutated := strconv.Itoa(originalInt)
-
-		return []mutator.Mutation{
-			{
-				Change: func() {
-					n.Value = mutated
-				},
-				Reset: func() {
-					n.Value = original
-				},
-			},
-		}
-	}
-
-	if n.Kind == token.FLOAT {
-		original := n.Value
-		originalFloat, err := strconv.ParseFloat(n.Value, 64)
-		if err != nil {
-			return nil
-		}
-
-		originalFloat--
-		mutated := strconv.FormatFloat(originalFloat, 'f', -1, 64)
+	return mutateNumber(node, -defaultStep)
+}
 
-		return []mutator.Mutation{
-			{
-				Change: func() {
-					n.Value = mutated
-				},
-				Reset: func() {
-					n.Value = original
-				},
-			},
-		}
+// NewMutatorNumbersDecrementer returns a mutator to decrement int and float by the "step" parameter, which is 1 by default.
+func NewMutatorNumbersDecrementer(config mutator.Config) (mutator.Mutator, error) {
+	step, err := configStep(config)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	return func(_ *types.Package, _ *types.Info, node ast.Node) []mutator.Mutation {
+		return mutateNumber(node, -step)
+	}, nil
 }
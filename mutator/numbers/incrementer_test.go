@@ -1,8 +1,14 @@
 package numbers
 
 import (
+	"go/ast"
+	"go/token"
+	"strings"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
 	"github.com/VirtualRoyalty/go-mutesting/test"
 )
 
@@ -14,3 +20,28 @@ func TestMutatorNumbersIncrementer(t *testing.T) {
 		2,
 	)
 }
+
+func TestNewMutatorNumbersIncrementer(t *testing.T) {
+	m, err := NewMutatorNumbersIncrementer(mutator.Config{"step": 10})
+	assert.NoError(t, err)
+
+	for value, expected := range map[string]string{"100": "110", "10.1": "20.1"} {
+		n := &ast.BasicLit{Kind: token.INT, Value: value}
+		if strings.Contains(value, ".") {
+			n.Kind = token.FLOAT
+		}
+
+		mutations := m(nil, nil, n)
+		assert.Len(t, mutations, 1)
+
+		mutations[0].Change()
+		assert.Equal(t, expected, n.Value)
+		mutations[0].Reset()
+		assert.Equal(t, value, n.Value)
+	}
+
+	for _, config := range []mutator.Config{{"step": 0}, {"step": "10"}, {"steps": 10}} {
+		_, err := NewMutatorNumbersIncrementer(config)
+		assert.Error(t, err, config)
+	}
+}
@@ -0,0 +1,71 @@
+package numbers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strconv"
+
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+// defaultStep is the value which is added to or subtracted from numbers if no step is configured.
+const defaultStep = 1
+
+// configStep returns the positive "step" parameter of the config.
+func configStep(config mutator.Config) (int, error) {
+	err := config.Check("step")
+	if err != nil {
+		return 0, err
+	}
+
+	step, err := config.Int("step", defaultStep)
+	if err != nil {
+		return 0, err
+	} else if step <= 0 {
+		return 0, fmt.Errorf("parameter \"step\" must be positive but is %d", step)
+	}
+
+	return step, nil
+}
+
+// mutateNumber returns the mutation which adds delta to the int or float literal.
+func mutateNumber(node ast.Node, delta int) []mutator.Mutation {
+	n, ok := node.(*ast.BasicLit)
+	if !ok {
+		return nil
+	}
+
+	var mutated string
+	switch n.Kind {
+	case token.INT:
+		originalInt, err := strconv.Atoi(n.Value)
+		if err != nil {
+			return nil
+		}
+
+		mutated = strconv.Itoa(originalInt + delta)
+	case token.FLOAT:
+		originalFloat, err := strconv.ParseFloat(n.Value, 64)
+		if err != nil {
+			return nil
+		}
+
+		mutated = strconv.FormatFloat(originalFloat+float64(delta), 'f', -1, 64)
+	default:
+		return nil
+	}
+
+	original := n.Value
+
+	return []mutator.Mutation{
+		{
+			Change: func() {
+				n.Value = mutated
+			},
+			Reset: func() {
+				n.Value = original
+			},
+		},
+	}
+}
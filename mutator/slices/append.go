@@ -0,0 +1,82 @@
+package slices
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/VirtualRoyalty/go-mutesting/astutil"
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("slices/append", MutatorAppend)
+}
+
+// MutatorAppend implements a mutator for calls of the append builtin.
+// Every appended element of append(s, a, b) is dropped on its own and append(dst, src...) is replaced by dst.
+// Elements which are the only use of a local variable or an imported package are not dropped since the mutation would not compile.
+func MutatorAppend(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	if info == nil {
+		return nil
+	}
+
+	var mutations []mutator.Mutation
+
+	if call, ok := node.(*ast.CallExpr); ok && isAppend(info, call) && !call.Ellipsis.IsValid() {
+		original := call.Args
+
+		for i := 1; i < len(original); i++ {
+			if !astutil.RemovableExpr(info, original[i]) {
+				continue
+			}
+
+			i := i
+
+			mutations = append(mutations, mutator.Mutation{
+				Change: func() {
+					args := make([]ast.Expr, 0, len(original)-1)
+					args = append(args, original[:i]...)
+					call.Args = append(args, original[i+1:]...)
+				},
+				Reset: func() {
+					call.Args = original
+				},
+			})
+		}
+	}
+
+	// The call with a spread slice is replaced by its destination in its parent
+	for _, x := range astutil.ValueExprs(node) {
+		call, ok := (*x).(*ast.CallExpr)
+		if !ok || !isAppend(info, call) || !call.Ellipsis.IsValid() || len(call.Args) != 2 || !astutil.RemovableExpr(info, call.Args[1]) {
+			continue
+		}
+
+		x := x
+		original := *x
+		mutated := call.Args[0]
+
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				*x = mutated
+			},
+			Reset: func() {
+				*x = original
+			},
+		})
+	}
+
+	return mutations
+}
+
+// isAppend returns true if the call is a call of the append builtin.
+func isAppend(info *types.Info, call *ast.CallExpr) bool {
+	id, ok := call.Fun.(*ast.Ident)
+	if !ok {
+		return false
+	}
+
+	builtin, ok := info.Uses[id].(*types.Builtin)
+
+	return ok && builtin.Name() == "append"
+}
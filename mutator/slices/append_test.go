@@ -0,0 +1,16 @@
+package slices
+
+import (
+	"testing"
+
+	"github.com/VirtualRoyalty/go-mutesting/test"
+)
+
+func TestMutatorAppend(t *testing.T) {
+	test.Mutator(
+		t,
+		MutatorAppend,
+		"../../testdata/slices/append.go",
+		3,
+	)
+}
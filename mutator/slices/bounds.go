@@ -0,0 +1,129 @@
+package slices
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strconv"
+
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("slices/bounds", MutatorBounds)
+}
+
+// MutatorBounds implements a mutator to move the bounds of slice expressions by one.
+// The low bound of s[a:b] is incremented and its high bound decremented, a missing high bound is replaced by len(s)-1.
+func MutatorBounds(_ *types.Package, _ *types.Info, node ast.Node) []mutator.Mutation {
+	n, ok := node.(*ast.SliceExpr)
+	if !ok {
+		return nil
+	}
+
+	// Constant bounds must stay ordered, otherwise the mutated slice expression does not compile
+	low, lowConstant := intLiteral(n.Low)
+	high, highConstant := intLiteral(n.High)
+	ordered := !lowConstant || !highConstant
+
+	var mutations []mutator.Mutation
+
+	if n.Low != nil && (ordered || low+1 <= high) {
+		if mutated := shiftBound(n.Low, token.ADD); mutated != nil {
+			original := n.Low
+
+			mutations = append(mutations, mutator.Mutation{
+				Change: func() {
+					n.Low = mutated
+				},
+				Reset: func() {
+					n.Low = original
+				},
+			})
+		}
+	}
+
+	var mutated ast.Expr
+	if n.High != nil {
+		if ordered || high-1 >= low {
+			mutated = shiftBound(n.High, token.SUB)
+		}
+	} else if !n.Slice3 && sideEffectFree(n.X) {
+		// The length is only evaluated again for expressions without side effects
+		mutated = &ast.BinaryExpr{
+			X: &ast.CallExpr{
+				Fun:  ast.NewIdent("len"),
+				Args: []ast.Expr{n.X},
+			},
+			Op: token.SUB,
+			Y:  &ast.BasicLit{Kind: token.INT, Value: "1"},
+		}
+	}
+	if mutated != nil {
+		original := n.High
+
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				n.High = mutated
+			},
+			Reset: func() {
+				n.High = original
+			},
+		})
+	}
+
+	return mutations
+}
+
+// shiftBound returns the bound incremented or decremented by one, integer literals are shifted directly and negative bounds are not created.
+func shiftBound(bound ast.Expr, op token.Token) ast.Expr {
+	if lit, ok := bound.(*ast.BasicLit); ok && lit.Kind == token.INT {
+		value, ok := intLiteral(lit)
+		if !ok {
+			return nil
+		}
+
+		if op == token.ADD {
+			value++
+		} else {
+			value--
+		}
+		if value < 0 {
+			return nil
+		}
+
+		return &ast.BasicLit{Kind: token.INT, Value: strconv.FormatInt(value, 10)}
+	}
+
+	return &ast.BinaryExpr{
+		X:  bound,
+		Op: op,
+		Y:  &ast.BasicLit{Kind: token.INT, Value: "1"},
+	}
+}
+
+// intLiteral returns the value of the given bound if it is an integer literal.
+func intLiteral(x ast.Expr) (int64, bool) {
+	lit, ok := x.(*ast.BasicLit)
+	if !ok || lit.Kind != token.INT {
+		return 0, false
+	}
+
+	value, err := strconv.ParseInt(lit.Value, 0, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return value, true
+}
+
+func sideEffectFree(x ast.Expr) bool {
+	switch n := x.(type) {
+	case *ast.Ident:
+		return true
+	case *ast.SelectorExpr:
+		return sideEffectFree(n.X)
+	}
+
+	return false
+}
@@ -0,0 +1,16 @@
+package slices
+
+import (
+	"testing"
+
+	"github.com/VirtualRoyalty/go-mutesting/test"
+)
+
+func TestMutatorBounds(t *testing.T) {
+	test.Mutator(
+		t,
+		MutatorBounds,
+		"../../testdata/slices/bounds.go",
+		5,
+	)
+}
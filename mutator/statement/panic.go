@@ -0,0 +1,163 @@
+package statement
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/VirtualRoyalty/go-mutesting/astutil"
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("statement/panic", MutatorPanic)
+}
+
+// MutatorPanic implements a mutator to remove panic statements, e.g. of violated invariants.
+// The mutator works on functions as a panic which terminates the function body has to be replaced by a return statement, which is only possible if the function has no or named results. Otherwise such a panic is not mutated.
+func MutatorPanic(pkg *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	if info == nil {
+		return nil
+	}
+
+	var typ *ast.FuncType
+	var body *ast.BlockStmt
+
+	switch n := node.(type) {
+	case *ast.FuncDecl:
+		typ, body = n.Type, n.Body
+	case *ast.FuncLit:
+		typ, body = n.Type, n.Body
+	}
+	if body == nil {
+		return nil
+	}
+
+	terminating := map[ast.Stmt]bool{}
+	terminatingPanics(info, body, terminating)
+
+	var mutations []mutator.Mutation
+
+	ast.Inspect(body, func(node ast.Node) bool {
+		var l []ast.Stmt
+
+		switch n := node.(type) {
+		case *ast.FuncLit:
+			// Function literals are mutated on their own
+			return false
+		case *ast.BlockStmt:
+			l = n.List
+		case *ast.CaseClause:
+			l = n.Body
+		case *ast.CommClause:
+			l = n.Body
+		}
+
+		for i, stmt := range l {
+			if !isPanic(info, stmt) {
+				continue
+			}
+
+			var mutated ast.Stmt
+			if terminating[stmt] {
+				if !returnsWithoutValues(typ) {
+					continue
+				}
+
+				mutated = &ast.ReturnStmt{
+					Return: stmt.Pos(),
+				}
+			} else {
+				mutated = astutil.CreateNoopOfStatement(pkg, info, stmt)
+			}
+
+			li := i
+			old := l[li]
+
+			mutations = append(mutations, mutator.Mutation{
+				Change: func() {
+					l[li] = mutated
+				},
+				Reset: func() {
+					l[li] = old
+				},
+				Pos: old.Pos(),
+			})
+		}
+
+		return true
+	})
+
+	return mutations
+}
+
+// terminatingPanics collects the panic statements which make the given statement terminating, so the function body would miss a return statement without them.
+func terminatingPanics(info *types.Info, stmt ast.Stmt, panics map[ast.Stmt]bool) {
+	switch s := stmt.(type) {
+	case *ast.ExprStmt:
+		if isPanic(info, s) {
+			panics[s] = true
+		}
+	case *ast.BlockStmt:
+		if len(s.List) > 0 {
+			terminatingPanics(info, s.List[len(s.List)-1], panics)
+		}
+	case *ast.LabeledStmt:
+		terminatingPanics(info, s.Stmt, panics)
+	case *ast.IfStmt:
+		if s.Else != nil {
+			terminatingPanics(info, s.Body, panics)
+			terminatingPanics(info, s.Else, panics)
+		}
+	case *ast.SwitchStmt:
+		terminatingClauses(info, s.Body, panics)
+	case *ast.TypeSwitchStmt:
+		terminatingClauses(info, s.Body, panics)
+	case *ast.SelectStmt:
+		terminatingClauses(info, s.Body, panics)
+	}
+}
+
+// terminatingClauses collects the terminating panic statements of the clauses of a switch or select statement.
+func terminatingClauses(info *types.Info, body *ast.BlockStmt, panics map[ast.Stmt]bool) {
+	for _, clause := range body.List {
+		var l []ast.Stmt
+
+		switch c := clause.(type) {
+		case *ast.CaseClause:
+			l = c.Body
+		case *ast.CommClause:
+			l = c.Body
+		}
+
+		if len(l) > 0 {
+			terminatingPanics(info, l[len(l)-1], panics)
+		}
+	}
+}
+
+// isPanic returns true if the statement is a call of the panic builtin.
+func isPanic(info *types.Info, stmt ast.Stmt) bool {
+	s, ok := stmt.(*ast.ExprStmt)
+	if !ok {
+		return false
+	}
+
+	call, ok := s.X.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+
+	id, ok := call.Fun.(*ast.Ident)
+	if !ok {
+		return false
+	}
+
+	builtin, ok := info.Uses[id].(*types.Builtin)
+
+	return ok && builtin.Name() == "panic"
+}
+
+// returnsWithoutValues returns true if a return statement without values is valid for the function type.
+func returnsWithoutValues(typ *ast.FuncType) bool {
+	return typ.Results == nil || len(typ.Results.List) == 0 || len(typ.Results.List[0].Names) > 0
+}
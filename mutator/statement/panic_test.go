@@ -0,0 +1,16 @@
+package statement
+
+import (
+	"testing"
+
+	"github.com/VirtualRoyalty/go-mutesting/test"
+)
+
+func TestMutatorPanic(t *testing.T) {
+	test.Mutator(
+		t,
+		MutatorPanic,
+		"../../testdata/statement/panic.go",
+		5,
+	)
+}
@@ -57,6 +57,7 @@ func MutatorRemoveStatement(pkg *types.Package, info *types.Info, node ast.Node)
 				Reset: func() {
 					l[li] = old
 				},
+				Pos: old.Pos(),
 			})
 		}
 	}
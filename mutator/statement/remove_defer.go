@@ -0,0 +1,103 @@
+package statement
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("statement/remove_defer", MutatorRemoveDefer)
+}
+
+// MutatorRemoveDefer implements a mutator to remove defer statements, e.g. of Close, Unlock and recover wrappers.
+func MutatorRemoveDefer(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	if info == nil {
+		return nil
+	}
+
+	var l []ast.Stmt
+
+	switch n := node.(type) {
+	case *ast.BlockStmt:
+		l = n.List
+	case *ast.CaseClause:
+		l = n.Body
+	case *ast.CommClause:
+		l = n.Body
+	}
+
+	var mutations []mutator.Mutation
+
+	for i, stmt := range l {
+		d, ok := stmt.(*ast.DeferStmt)
+		if !ok {
+			continue
+		}
+
+		li := i
+		old := l[li]
+
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				l[li] = noopOfDefer(info, d)
+			},
+			Reset: func() {
+				l[li] = old
+			},
+			Pos: old.Pos(),
+		})
+	}
+
+	return mutations
+}
+
+// noopOfDefer returns a statement which uses the variables of the defer statement which are declared outside of it, so they are still used after removing it.
+func noopOfDefer(info *types.Info, d *ast.DeferStmt) ast.Stmt {
+	var used []ast.Expr
+	seen := map[types.Object]bool{}
+
+	var inspect func(node ast.Node) bool
+	inspect = func(node ast.Node) bool {
+		switch n := node.(type) {
+		case *ast.SelectorExpr:
+			// Only the operand of a selector can be a variable of this package
+			ast.Inspect(n.X, inspect)
+
+			return false
+		case *ast.Ident:
+			v, ok := info.Uses[n].(*types.Var)
+			if !ok || v.IsField() || seen[v] || (v.Pos() >= d.Pos() && v.Pos() < d.End()) {
+				return false
+			} else if v.Pkg() != nil && v.Parent() == v.Pkg().Scope() {
+				// Package variables do not have to be used
+				return false
+			}
+			seen[v] = true
+
+			used = append(used, ast.NewIdent(n.Name))
+		}
+
+		return true
+	}
+	ast.Inspect(d.Call, inspect)
+
+	if len(used) == 0 {
+		return &ast.EmptyStmt{
+			Semicolon: token.NoPos,
+		}
+	}
+
+	lhs := make([]ast.Expr, len(used))
+	for i := range used {
+		lhs[i] = ast.NewIdent("_")
+	}
+
+	return &ast.AssignStmt{
+		Lhs: lhs,
+		Rhs: used,
+		Tok: token.ASSIGN,
+	}
+}
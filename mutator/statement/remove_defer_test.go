@@ -0,0 +1,16 @@
+package statement
+
+import (
+	"testing"
+
+	"github.com/VirtualRoyalty/go-mutesting/test"
+)
+
+func TestMutatorRemoveDefer(t *testing.T) {
+	test.Mutator(
+		t,
+		MutatorRemoveDefer,
+		"../../testdata/statement/remove_defer.go",
+		4,
+	)
+}
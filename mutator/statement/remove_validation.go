@@ -0,0 +1,283 @@
+package statement
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"regexp"
+
+	"github.com/VirtualRoyalty/go-mutesting/astutil"
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.RegisterConfigurable("statement/remove_validation", NewMutatorRemoveValidation)
+}
+
+// defaultValidationPattern matches the names of functions and methods which are treated as validations if the "pattern" parameter is not set.
+const defaultValidationPattern = `(?i)^(validate|check|verify)`
+
+var defaultRemoveValidation = newRemoveValidationMutator(regexp.MustCompile(defaultValidationPattern))
+
+// MutatorRemoveValidation implements a mutator to remove validation calls whose error result is checked.
+// Both "if err := Validate(); err != nil { ... }" and "err := Validate()" followed by "if err != nil { ... }" are collapsed to the happy path.
+// Checks with an else branch are not mutated.
+func MutatorRemoveValidation(pkg *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	return defaultRemoveValidation(pkg, info, node)
+}
+
+// NewMutatorRemoveValidation returns a mutator to remove validation calls whose names match the regex of the "pattern" parameter.
+func NewMutatorRemoveValidation(config mutator.Config) (mutator.Mutator, error) {
+	err := config.Check("pattern")
+	if err != nil {
+		return nil, err
+	}
+
+	pattern, err := config.String("pattern", defaultValidationPattern)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("pattern %q is not a valid regex: %v", pattern, err)
+	}
+
+	return newRemoveValidationMutator(r), nil
+}
+
+func newRemoveValidationMutator(pattern *regexp.Regexp) mutator.Mutator {
+	return func(pkg *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+		if info == nil {
+			return nil
+		}
+
+		var l []ast.Stmt
+
+		switch n := node.(type) {
+		case *ast.BlockStmt:
+			l = n.List
+		case *ast.CaseClause:
+			l = n.Body
+		case *ast.CommClause:
+			l = n.Body
+		}
+
+		var mutations []mutator.Mutation
+
+		for i, stmt := range l {
+			ifStmt, ok := stmt.(*ast.IfStmt)
+			if !ok || ifStmt.Else != nil {
+				continue
+			}
+
+			checked := checkedError(ifStmt.Cond)
+			if checked == nil {
+				continue
+			}
+
+			li := i
+
+			if ifStmt.Init == nil {
+				// Validation call which is checked directly e.g. "if Validate() != nil"
+				if call, ok := checked.(*ast.CallExpr); ok && isValidationCall(pattern, info, call) {
+					old := l[li]
+
+					mutations = append(mutations, mutator.Mutation{
+						Change: func() {
+							l[li] = createNoopOfValidation(pkg, info, old)
+						},
+						Reset: func() {
+							l[li] = old
+						},
+					})
+
+					continue
+				}
+
+				// Validation call which is assigned in the previous statement e.g. "err := Validate()"
+				if li == 0 {
+					continue
+				}
+
+				assign, call := validationAssignment(pattern, info, l[li-1], checked)
+				if assign == nil {
+					continue
+				}
+
+				oldAssign := l[li-1]
+				oldIf := l[li]
+
+				var mutatedAssign ast.Stmt = &ast.EmptyStmt{
+					Semicolon: token.NoPos,
+				}
+				if assign.Tok == token.DEFINE {
+					mutatedAssign = &ast.DeclStmt{
+						Decl: &ast.GenDecl{
+							Tok: token.VAR,
+							Specs: []ast.Spec{
+								&ast.ValueSpec{
+									Names: []*ast.Ident{ast.NewIdent(assign.Lhs[0].(*ast.Ident).Name)},
+									Type:  ast.NewIdent("error"),
+								},
+							},
+						},
+					}
+				}
+
+				mutations = append(mutations, mutator.Mutation{
+					Change: func() {
+						l[li-1] = mutatedAssign
+						l[li] = createNoopOfValidation(pkg, info, &ast.ExprStmt{X: call}, oldIf)
+					},
+					Reset: func() {
+						l[li-1] = oldAssign
+						l[li] = oldIf
+					},
+				})
+
+				continue
+			}
+
+			// Validation call which is assigned in the if statement e.g. "if err := Validate(); err != nil"
+			if assign, _ := validationAssignment(pattern, info, ifStmt.Init, checked); assign != nil {
+				old := l[li]
+
+				mutations = append(mutations, mutator.Mutation{
+					Change: func() {
+						l[li] = createNoopOfValidation(pkg, info, old)
+					},
+					Reset: func() {
+						l[li] = old
+					},
+				})
+			}
+		}
+
+		return mutations
+	}
+}
+
+// checkedError returns the expression which is compared to nil with "!=".
+func checkedError(cond ast.Expr) ast.Expr {
+	n, ok := cond.(*ast.BinaryExpr)
+	if !ok || n.Op != token.NEQ {
+		return nil
+	}
+
+	if isNil(n.Y) {
+		return n.X
+	} else if isNil(n.X) {
+		return n.Y
+	}
+
+	return nil
+}
+
+func isNil(x ast.Expr) bool {
+	n, ok := x.(*ast.Ident)
+
+	return ok && n.Name == "nil"
+}
+
+// validationAssignment returns the assignment and its validation call if the given statement assigns the result of a validation call to the checked identifier.
+func validationAssignment(pattern *regexp.Regexp, info *types.Info, stmt ast.Stmt, checked ast.Expr) (*ast.AssignStmt, *ast.CallExpr) {
+	assign, ok := stmt.(*ast.AssignStmt)
+	if !ok || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+		return nil, nil
+	}
+	if assign.Tok != token.DEFINE && assign.Tok != token.ASSIGN {
+		return nil, nil
+	}
+
+	lhs, ok := assign.Lhs[0].(*ast.Ident)
+	if !ok || lhs.Name == "_" {
+		return nil, nil
+	}
+
+	id, ok := checked.(*ast.Ident)
+	if !ok || id.Name != lhs.Name {
+		return nil, nil
+	}
+
+	call, ok := assign.Rhs[0].(*ast.CallExpr)
+	if !ok || !isValidationCall(pattern, info, call) {
+		return nil, nil
+	}
+
+	return assign, call
+}
+
+// isValidationCall checks if the given call matches the validation pattern and returns only an error.
+func isValidationCall(pattern *regexp.Regexp, info *types.Info, call *ast.CallExpr) bool {
+	var name string
+
+	switch fun := call.Fun.(type) {
+	case *ast.Ident:
+		name = fun.Name
+	case *ast.SelectorExpr:
+		name = fun.Sel.Name
+	default:
+		return false
+	}
+
+	if !pattern.MatchString(name) {
+		return false
+	}
+
+	t := info.TypeOf(call)
+
+	return t != nil && types.Identical(t, types.Universe.Lookup("error").Type())
+}
+
+// createNoopOfValidation creates a noop statement out of the given statements which keeps all used variables except the ones which are declared inside of the statements.
+func createNoopOfValidation(pkg *types.Package, info *types.Info, stmts ...ast.Stmt) ast.Stmt {
+	declared := map[string]struct{}{}
+	for _, stmt := range stmts {
+		ast.Inspect(stmt, func(n ast.Node) bool {
+			if id, ok := n.(*ast.Ident); ok {
+				if _, ok := info.Defs[id]; ok {
+					declared[id.Name] = struct{}{}
+				}
+			}
+
+			return true
+		})
+	}
+
+	var ids []ast.Expr
+	seen := map[string]struct{}{}
+	for _, stmt := range stmts {
+		for _, id := range astutil.IdentifiersInStatement(pkg, info, stmt) {
+			if n, ok := id.(*ast.Ident); ok {
+				if _, ok := declared[n.Name]; ok {
+					continue
+				}
+				if _, ok := seen[n.Name]; ok {
+					continue
+				}
+				seen[n.Name] = struct{}{}
+			}
+
+			ids = append(ids, id)
+		}
+	}
+
+	if len(ids) == 0 {
+		return &ast.EmptyStmt{
+			Semicolon: token.NoPos,
+		}
+	}
+
+	lhs := make([]ast.Expr, len(ids))
+	for i := range ids {
+		lhs[i] = ast.NewIdent("_")
+	}
+
+	return &ast.AssignStmt{
+		Lhs: lhs,
+		Rhs: ids,
+		Tok: token.ASSIGN,
+	}
+}
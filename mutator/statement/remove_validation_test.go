@@ -0,0 +1,41 @@
+package statement
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/VirtualRoyalty/go-mutesting"
+	"github.com/VirtualRoyalty/go-mutesting/internal/parser"
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+	"github.com/VirtualRoyalty/go-mutesting/test"
+)
+
+func TestMutatorRemoveValidation(t *testing.T) {
+	test.Mutator(
+		t,
+		MutatorRemoveValidation,
+		"../../testdata/statement/remove_validation.go",
+		4,
+	)
+}
+
+func TestNewMutatorRemoveValidation(t *testing.T) {
+	src, _, pkg, info, err := parser.ParseAndTypeCheckFile("../../testdata/statement/remove_validation.go", "", nil)
+	assert.NoError(t, err)
+
+	for count, pattern := range map[int]string{
+		3: "^check",
+		1: "^Validate$",
+		0: "^Ensure",
+	} {
+		m, err := NewMutatorRemoveValidation(mutator.Config{"pattern": pattern})
+		assert.NoError(t, err)
+		assert.Equal(t, count, mutesting.CountWalk(pkg, info, src, m), pattern)
+	}
+
+	for _, config := range []mutator.Config{{"pattern": "("}, {"pattern": []string{"^check"}}, {"patterns": "^check"}} {
+		_, err := NewMutatorRemoveValidation(config)
+		assert.Error(t, err, config)
+	}
+}
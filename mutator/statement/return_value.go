@@ -0,0 +1,119 @@
+package statement
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+
+	"github.com/VirtualRoyalty/go-mutesting/astutil"
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("statement/return_value", MutatorReturnValue)
+}
+
+// MutatorReturnValue implements a mutator to replace the returned values by values of their types which callers should notice.
+// Numbers are replaced by 0, or by 1 if they are 0, booleans are negated, strings are replaced by "" and pointers, interfaces, slices, maps, channels and functions by nil.
+// Values are kept if they hold the only usage of a local variable or an imported package.
+func MutatorReturnValue(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	if info == nil {
+		return nil
+	}
+
+	n, ok := node.(*ast.ReturnStmt)
+	if !ok {
+		return nil
+	}
+
+	var mutations []mutator.Mutation
+
+	for i, result := range n.Results {
+		tv, ok := info.Types[result]
+		if !ok || !astutil.RemovableExpr(info, result) {
+			continue
+		}
+
+		mutated := returnValueMutation(result, tv)
+		if mutated == nil {
+			continue
+		}
+
+		i := i
+		original := result
+
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				n.Results[i] = mutated
+			},
+			Reset: func() {
+				n.Results[i] = original
+			},
+		})
+	}
+
+	return mutations
+}
+
+// returnValueMutation returns the replacement of the returned expression or nil if its type is not mutated.
+func returnValueMutation(expr ast.Expr, tv types.TypeAndValue) ast.Expr {
+	if tv.Type == nil || tv.IsNil() {
+		return nil
+	}
+
+	// Calls with multiple results and type parameters have no single value which is assignable
+	switch tv.Type.(type) {
+	case *types.Tuple, *types.TypeParam:
+		return nil
+	}
+
+	switch t := tv.Type.Underlying().(type) {
+	case *types.Basic:
+		switch {
+		case t.Info()&types.IsBoolean != 0:
+			if tv.Value != nil {
+				return ast.NewIdent(boolString(!constant.BoolVal(tv.Value)))
+			}
+
+			return &ast.UnaryExpr{
+				Op: token.NOT,
+				X:  negatable(expr),
+			}
+		case t.Info()&types.IsNumeric != 0:
+			if tv.Value != nil && constant.Sign(tv.Value) == 0 {
+				return &ast.BasicLit{Kind: token.INT, Value: "1"}
+			}
+
+			return &ast.BasicLit{Kind: token.INT, Value: "0"}
+		case t.Info()&types.IsString != 0:
+			if tv.Value != nil && constant.StringVal(tv.Value) == "" {
+				return nil
+			}
+
+			return &ast.BasicLit{Kind: token.STRING, Value: `""`}
+		}
+	case *types.Pointer, *types.Interface, *types.Slice, *types.Map, *types.Chan, *types.Signature:
+		return ast.NewIdent("nil")
+	}
+
+	return nil
+}
+
+// negatable returns the expression in parentheses unless it can be negated as it is.
+func negatable(expr ast.Expr) ast.Expr {
+	switch expr.(type) {
+	case *ast.Ident, *ast.CallExpr, *ast.SelectorExpr, *ast.ParenExpr, *ast.IndexExpr:
+		return expr
+	}
+
+	return &ast.ParenExpr{X: expr}
+}
+
+func boolString(b bool) string {
+	if b {
+		return "true"
+	}
+
+	return "false"
+}
@@ -0,0 +1,16 @@
+package statement
+
+import (
+	"testing"
+
+	"github.com/VirtualRoyalty/go-mutesting/test"
+)
+
+func TestMutatorReturnValue(t *testing.T) {
+	test.Mutator(
+		t,
+		MutatorReturnValue,
+		"../../testdata/statement/return_value.go",
+		9,
+	)
+}
@@ -0,0 +1,131 @@
+package stdlib
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/VirtualRoyalty/go-mutesting/astutil"
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("stdlib/context", MutatorContext)
+}
+
+const contextPath = "context"
+
+// cancelFuncTypes are the types of the cancel functions of the context package.
+var cancelFuncTypes = map[string]struct{}{
+	"CancelFunc":      {},
+	"CancelCauseFunc": {},
+}
+
+// MutatorContext implements a mutator for the cancellation of contexts.
+// Calls of cancel functions which are used as statements are removed and ctx.Err() is replaced by a nil error, so the context looks like it is never canceled.
+// Returned errors are not replaced since errors/return_nil already does.
+func MutatorContext(pkg *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	if info == nil {
+		return nil
+	} else if _, ok := node.(*ast.ReturnStmt); ok {
+		return nil
+	}
+
+	var l []ast.Stmt
+
+	switch n := node.(type) {
+	case *ast.BlockStmt:
+		l = n.List
+	case *ast.CaseClause:
+		l = n.Body
+	case *ast.CommClause:
+		l = n.Body
+	}
+
+	var mutations []mutator.Mutation
+
+	for i, stmt := range l {
+		if !isCancelStatement(info, stmt) {
+			continue
+		}
+
+		li := i
+		old := l[li]
+
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				l[li] = astutil.CreateNoopOfStatement(pkg, info, old)
+			},
+			Reset: func() {
+				l[li] = old
+			},
+			Pos: old.Pos(),
+		})
+	}
+
+	for _, x := range astutil.ValueExprs(node) {
+		if !isContextErr(info, *x) {
+			continue
+		}
+
+		x := x
+		original := *x
+
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				*x = &ast.CallExpr{
+					Fun:  ast.NewIdent("error"),
+					Args: []ast.Expr{ast.NewIdent("nil")},
+				}
+			},
+			Reset: func() {
+				*x = original
+			},
+		})
+	}
+
+	return mutations
+}
+
+// isCancelStatement returns true for statements which call a cancel function of the context package, e.g. "cancel()".
+func isCancelStatement(info *types.Info, stmt ast.Stmt) bool {
+	s, ok := stmt.(*ast.ExprStmt)
+	if !ok {
+		return false
+	}
+
+	call, ok := s.X.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+
+	named, ok := info.TypeOf(call.Fun).(*types.Named)
+	if !ok || named.Obj().Pkg() == nil || named.Obj().Pkg().Path() != contextPath {
+		return false
+	}
+
+	_, ok = cancelFuncTypes[named.Obj().Name()]
+
+	return ok
+}
+
+// isContextErr returns true for calls of the Err method of a context.Context.
+func isContextErr(info *types.Info, x ast.Expr) bool {
+	call, ok := x.(*ast.CallExpr)
+	if !ok || len(call.Args) != 0 {
+		return false
+	}
+
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+
+	selection, ok := info.Selections[sel]
+	if !ok {
+		return false
+	}
+
+	fn, ok := selection.Obj().(*types.Func)
+
+	return ok && fn.FullName() == "(context.Context).Err"
+}
@@ -0,0 +1,16 @@
+package stdlib
+
+import (
+	"testing"
+
+	"github.com/VirtualRoyalty/go-mutesting/test"
+)
+
+func TestMutatorContext(t *testing.T) {
+	test.Mutator(
+		t,
+		MutatorContext,
+		"../../testdata/stdlib/context.go",
+		4,
+	)
+}
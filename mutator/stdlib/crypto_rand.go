@@ -0,0 +1,189 @@
+package stdlib
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+
+	"github.com/VirtualRoyalty/go-mutesting/astutil"
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.RegisterOptIn("stdlib/crypto_rand", MutatorCryptoRand)
+}
+
+const (
+	cryptoRandPath = "crypto/rand"
+	mathRandPath   = "math/rand"
+)
+
+// mathRandSeedFunctions are the math/rand functions whose only argument is a seed.
+var mathRandSeedFunctions = map[string]struct{}{
+	"NewSource": {},
+	"Seed":      {},
+}
+
+// MutatorCryptoRand implements a mutator to substitute weak randomness for crypto/rand, e.g. in the generation of tokens and nonces.
+// rand.Reader and rand.Read of crypto/rand are swapped with a math/rand source with a fixed seed if the file imports math/rand as well,
+// and the constant seeds of math/rand are changed.
+func MutatorCryptoRand(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	if info == nil {
+		return nil
+	}
+
+	var mutations []mutator.Mutation
+
+	switch n := node.(type) {
+	case *ast.CallExpr:
+		if mutation, ok := mutateCryptoRandRead(info, n); ok {
+			mutations = append(mutations, mutation)
+		}
+		if mutation, ok := mutateMathRandSeed(info, n); ok {
+			mutations = append(mutations, mutation)
+		}
+
+		for i := range n.Args {
+			if mutation, ok := mutateCryptoRandReader(info, &n.Args[i]); ok {
+				mutations = append(mutations, mutation)
+			}
+		}
+	case *ast.SelectorExpr:
+		if mutation, ok := mutateCryptoRandReader(info, &n.X); ok {
+			mutations = append(mutations, mutation)
+		}
+	case *ast.KeyValueExpr:
+		if mutation, ok := mutateCryptoRandReader(info, &n.Value); ok {
+			mutations = append(mutations, mutation)
+		}
+	case *ast.AssignStmt:
+		for i := range n.Rhs {
+			if mutation, ok := mutateCryptoRandReader(info, &n.Rhs[i]); ok {
+				mutations = append(mutations, mutation)
+			}
+		}
+	case *ast.ValueSpec:
+		for i := range n.Values {
+			if mutation, ok := mutateCryptoRandReader(info, &n.Values[i]); ok {
+				mutations = append(mutations, mutation)
+			}
+		}
+	}
+
+	return mutations
+}
+
+// mutateCryptoRandReader replaces the expression if it is crypto/rand.Reader with a math/rand source with a fixed seed, e.g. rand.New(rand.NewSource(0)).
+func mutateCryptoRandReader(info *types.Info, x *ast.Expr) (mutator.Mutation, bool) {
+	sel, ok := (*x).(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Reader" {
+		return mutator.Mutation{}, false
+	}
+
+	mathRand := mathRandInsteadOf(info, sel.X)
+	if mathRand == nil {
+		return mutator.Mutation{}, false
+	}
+
+	original := *x
+	mutated := &ast.CallExpr{
+		Fun: &ast.SelectorExpr{
+			X:   ast.NewIdent(mathRand.Name()),
+			Sel: ast.NewIdent("New"),
+		},
+		Args: []ast.Expr{
+			&ast.CallExpr{
+				Fun: &ast.SelectorExpr{
+					X:   ast.NewIdent(mathRand.Name()),
+					Sel: ast.NewIdent("NewSource"),
+				},
+				Args: []ast.Expr{
+					&ast.BasicLit{Kind: token.INT, Value: "0"},
+				},
+			},
+		},
+	}
+
+	return mutator.Mutation{
+		Change: func() {
+			*x = mutated
+		},
+		Reset: func() {
+			*x = original
+		},
+	}, true
+}
+
+// mutateCryptoRandRead swaps crypto/rand.Read with math/rand.Read.
+func mutateCryptoRandRead(info *types.Info, n *ast.CallExpr) (mutator.Mutation, bool) {
+	sel, ok := n.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Read" {
+		return mutator.Mutation{}, false
+	}
+
+	mathRand := mathRandInsteadOf(info, sel.X)
+	if mathRand == nil {
+		return mutator.Mutation{}, false
+	}
+
+	original := sel.X
+
+	return mutator.Mutation{
+		Change: func() {
+			sel.X = ast.NewIdent(mathRand.Name())
+		},
+		Reset: func() {
+			sel.X = original
+		},
+	}, true
+}
+
+// mathRandInsteadOf returns the package name of math/rand if the expression is the package name of crypto/rand,
+// math/rand is imported in the same file and crypto/rand is still used after the mutation.
+func mathRandInsteadOf(info *types.Info, x ast.Expr) *types.PkgName {
+	if astutil.PackagePath(info, x) != cryptoRandPath {
+		return nil
+	}
+
+	cryptoRand := info.Uses[x.(*ast.Ident)].(*types.PkgName)
+	mathRand := importedInFile(cryptoRand, mathRandPath)
+	if mathRand == nil || !astutil.PackageUsedElsewhere(info, cryptoRand) {
+		return nil
+	}
+
+	return mathRand
+}
+
+// mutateMathRandSeed changes the constant seed of math/rand.NewSource and math/rand.Seed to 0, or to 1 if it is 0.
+func mutateMathRandSeed(info *types.Info, n *ast.CallExpr) (mutator.Mutation, bool) {
+	sel, ok := n.Fun.(*ast.SelectorExpr)
+	if !ok || len(n.Args) != 1 || astutil.PackagePath(info, sel.X) != mathRandPath {
+		return mutator.Mutation{}, false
+	}
+	if _, ok := mathRandSeedFunctions[sel.Sel.Name]; !ok {
+		return mutator.Mutation{}, false
+	}
+
+	seed := info.Types[n.Args[0]].Value
+	if seed == nil || seed.Kind() != constant.Int {
+		return mutator.Mutation{}, false
+	}
+
+	mutatedSeed := "0"
+	if constant.Sign(seed) == 0 {
+		mutatedSeed = "1"
+	}
+
+	original := n.Args[0]
+	mutated := &ast.BasicLit{Kind: token.INT, Value: mutatedSeed}
+
+	return mutator.Mutation{
+		Change: func() {
+			n.Args[0] = mutated
+		},
+		Reset: func() {
+			n.Args[0] = original
+		},
+	}, true
+}
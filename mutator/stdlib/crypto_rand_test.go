@@ -0,0 +1,16 @@
+package stdlib
+
+import (
+	"testing"
+
+	"github.com/VirtualRoyalty/go-mutesting/test"
+)
+
+func TestMutatorCryptoRand(t *testing.T) {
+	test.Mutator(
+		t,
+		MutatorCryptoRand,
+		"../../testdata/stdlib/crypto_rand.go",
+		6,
+	)
+}
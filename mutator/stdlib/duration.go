@@ -0,0 +1,147 @@
+package stdlib
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"math"
+	"strconv"
+
+	"github.com/VirtualRoyalty/go-mutesting/astutil"
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("stdlib/duration", MutatorDuration)
+}
+
+const timePath = "time"
+
+// durationUnitMutations swaps every unit of the time package with a neighboring unit.
+var durationUnitMutations = map[string]string{
+	"Nanosecond":  "Microsecond",
+	"Microsecond": "Nanosecond",
+	"Millisecond": "Second",
+	"Second":      "Millisecond",
+	"Minute":      "Second",
+	"Hour":        "Minute",
+}
+
+// durationScale is the factor by which durations are scaled up.
+const durationScale = 10
+
+// MutatorDuration implements a mutator for durations which are a count multiplied by a unit of the time package, e.g. 5 * time.Second.
+// The count is set to 0 and scaled by 10, and the unit is swapped with a neighboring unit.
+func MutatorDuration(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	if info == nil {
+		return nil
+	}
+
+	n, ok := node.(*ast.BinaryExpr)
+	if !ok || n.Op != token.MUL {
+		return nil
+	}
+
+	count, unit := &n.X, durationUnit(info, n.Y)
+	if unit == nil {
+		count, unit = &n.Y, durationUnit(info, n.X)
+	}
+	if unit == nil {
+		return nil
+	}
+
+	var mutations []mutator.Mutation
+
+	originalCount := *count
+	for _, mutated := range []ast.Expr{zeroCount(info, originalCount), scaleCount(info, n, originalCount)} {
+		if mutated == nil {
+			continue
+		}
+
+		mutated := mutated
+
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				*count = mutated
+			},
+			Reset: func() {
+				*count = originalCount
+			},
+		})
+	}
+
+	originalUnit := unit.Sel
+	mutatedUnit := durationUnitMutations[unit.Sel.Name]
+
+	mutations = append(mutations, mutator.Mutation{
+		Change: func() {
+			unit.Sel = ast.NewIdent(mutatedUnit)
+		},
+		Reset: func() {
+			unit.Sel = originalUnit
+		},
+	})
+
+	return mutations
+}
+
+// durationUnit returns the selector of the expression if it is a unit of the time package.
+func durationUnit(info *types.Info, x ast.Expr) *ast.SelectorExpr {
+	sel, ok := x.(*ast.SelectorExpr)
+	if !ok || astutil.PackagePath(info, sel.X) != timePath {
+		return nil
+	} else if _, ok := durationUnitMutations[sel.Sel.Name]; !ok {
+		return nil
+	}
+
+	return sel
+}
+
+// zeroCount returns the count of a duration of zero.
+// Counts which are not constant are multiplied by zero, so their variables are still used.
+func zeroCount(info *types.Info, count ast.Expr) ast.Expr {
+	zero := &ast.BasicLit{
+		Kind:  token.INT,
+		Value: "0",
+	}
+
+	if tv, ok := info.Types[count]; ok && tv.Value != nil {
+		return zero
+	}
+
+	return &ast.BinaryExpr{
+		X:  count,
+		Op: token.MUL,
+		Y:  zero,
+	}
+}
+
+// scaleCount returns the scaled count of the duration or nil if the scaled duration overflows.
+func scaleCount(info *types.Info, duration ast.Expr, count ast.Expr) ast.Expr {
+	if tv, ok := info.Types[duration]; ok && tv.Value != nil {
+		scaled := constant.BinaryOp(tv.Value, token.MUL, constant.MakeInt64(durationScale))
+		if _, exact := constant.Int64Val(scaled); !exact {
+			return nil
+		}
+	}
+
+	if lit, ok := count.(*ast.BasicLit); ok && lit.Kind == token.INT {
+		value, err := strconv.ParseInt(lit.Value, 0, 64)
+		if err == nil && value <= math.MaxInt64/durationScale {
+			return &ast.BasicLit{
+				Kind:  token.INT,
+				Value: strconv.FormatInt(value*durationScale, 10),
+			}
+		}
+	}
+
+	return &ast.BinaryExpr{
+		X:  count,
+		Op: token.MUL,
+		Y: &ast.BasicLit{
+			Kind:  token.INT,
+			Value: strconv.Itoa(durationScale),
+		},
+	}
+}
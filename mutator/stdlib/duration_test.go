@@ -0,0 +1,16 @@
+package stdlib
+
+import (
+	"testing"
+
+	"github.com/VirtualRoyalty/go-mutesting/test"
+)
+
+func TestMutatorDuration(t *testing.T) {
+	test.Mutator(
+		t,
+		MutatorDuration,
+		"../../testdata/stdlib/duration.go",
+		9,
+	)
+}
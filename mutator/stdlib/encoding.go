@@ -0,0 +1,212 @@
+package stdlib
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/VirtualRoyalty/go-mutesting/astutil"
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("stdlib/encoding", MutatorEncoding)
+}
+
+const (
+	base64Path = "encoding/base64"
+	hexPath    = "encoding/hex"
+	jsonPath   = "encoding/json"
+)
+
+var base64EncodingMutations = map[string]string{
+	"StdEncoding":    "URLEncoding",
+	"URLEncoding":    "StdEncoding",
+	"RawStdEncoding": "RawURLEncoding",
+	"RawURLEncoding": "RawStdEncoding",
+}
+
+// hexBase64Functions are the hex functions which have a base64 encoding method with the same name and signature.
+var hexBase64Functions = map[string]struct{}{
+	"EncodeToString": {},
+	"DecodeString":   {},
+}
+
+// MutatorEncoding implements a mutator to swap encodings with the same signature.
+// json.Marshal is swapped with json.MarshalIndent, base64.StdEncoding with base64.URLEncoding, and hex helpers with their base64 counterparts.
+func MutatorEncoding(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	if info == nil {
+		return nil
+	}
+
+	switch n := node.(type) {
+	case *ast.SelectorExpr:
+		if astutil.PackagePath(info, n.X) != base64Path {
+			return nil
+		}
+
+		mutated, ok := base64EncodingMutations[n.Sel.Name]
+		if !ok {
+			return nil
+		}
+
+		original := n.Sel
+
+		return []mutator.Mutation{
+			{
+				Change: func() {
+					n.Sel = ast.NewIdent(mutated)
+				},
+				Reset: func() {
+					n.Sel = original
+				},
+			},
+		}
+	case *ast.CallExpr:
+		sel, ok := n.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return nil
+		}
+
+		if mutation, ok := mutateJSON(info, n, sel); ok {
+			return []mutator.Mutation{mutation}
+		}
+		if mutation, ok := mutateHex(info, n, sel); ok {
+			return []mutator.Mutation{mutation}
+		}
+		if mutation, ok := mutateBase64(info, n, sel); ok {
+			return []mutator.Mutation{mutation}
+		}
+	}
+
+	return nil
+}
+
+// mutateJSON swaps json.Marshal with json.MarshalIndent and the other way around.
+func mutateJSON(info *types.Info, n *ast.CallExpr, sel *ast.SelectorExpr) (mutator.Mutation, bool) {
+	if astutil.PackagePath(info, sel.X) != jsonPath {
+		return mutator.Mutation{}, false
+	}
+
+	var mutatedName string
+	var mutatedArgs []ast.Expr
+
+	switch {
+	case sel.Sel.Name == "Marshal" && len(n.Args) == 1:
+		mutatedName = "MarshalIndent"
+		mutatedArgs = []ast.Expr{
+			n.Args[0],
+			&ast.BasicLit{Kind: token.STRING, Value: `""`},
+			&ast.BasicLit{Kind: token.STRING, Value: `"\t"`},
+		}
+	case sel.Sel.Name == "MarshalIndent" && len(n.Args) == 3:
+		// Removed arguments must not be the only usage of a variable
+		for _, arg := range n.Args[1:] {
+			if _, ok := arg.(*ast.BasicLit); !ok {
+				return mutator.Mutation{}, false
+			}
+		}
+
+		mutatedName = "Marshal"
+		mutatedArgs = []ast.Expr{n.Args[0]}
+	default:
+		return mutator.Mutation{}, false
+	}
+
+	originalSel := sel.Sel
+	originalArgs := n.Args
+
+	return mutator.Mutation{
+		Change: func() {
+			sel.Sel = ast.NewIdent(mutatedName)
+			n.Args = mutatedArgs
+		},
+		Reset: func() {
+			sel.Sel = originalSel
+			n.Args = originalArgs
+		},
+	}, true
+}
+
+// mutateHex swaps hex helpers with the base64 standard encoding, e.g. hex.EncodeToString with base64.StdEncoding.EncodeToString.
+func mutateHex(info *types.Info, n *ast.CallExpr, sel *ast.SelectorExpr) (mutator.Mutation, bool) {
+	if _, ok := hexBase64Functions[sel.Sel.Name]; !ok || astutil.PackagePath(info, sel.X) != hexPath {
+		return mutator.Mutation{}, false
+	}
+
+	hex := info.Uses[sel.X.(*ast.Ident)].(*types.PkgName)
+	base64 := importedInFile(hex, base64Path)
+	if base64 == nil || !astutil.PackageUsedElsewhere(info, hex) {
+		return mutator.Mutation{}, false
+	}
+
+	original := n.Fun
+	mutated := &ast.SelectorExpr{
+		X: &ast.SelectorExpr{
+			X:   ast.NewIdent(base64.Name()),
+			Sel: ast.NewIdent("StdEncoding"),
+		},
+		Sel: ast.NewIdent(sel.Sel.Name),
+	}
+
+	return mutator.Mutation{
+		Change: func() {
+			n.Fun = mutated
+		},
+		Reset: func() {
+			n.Fun = original
+		},
+	}, true
+}
+
+// mutateBase64 swaps base64 encoding methods with their hex helpers, e.g. base64.StdEncoding.EncodeToString with hex.EncodeToString.
+func mutateBase64(info *types.Info, n *ast.CallExpr, sel *ast.SelectorExpr) (mutator.Mutation, bool) {
+	if _, ok := hexBase64Functions[sel.Sel.Name]; !ok {
+		return mutator.Mutation{}, false
+	}
+
+	encoding, ok := sel.X.(*ast.SelectorExpr)
+	if !ok || astutil.PackagePath(info, encoding.X) != base64Path {
+		return mutator.Mutation{}, false
+	}
+	if _, ok := base64EncodingMutations[encoding.Sel.Name]; !ok {
+		return mutator.Mutation{}, false
+	}
+
+	base64 := info.Uses[encoding.X.(*ast.Ident)].(*types.PkgName)
+	hex := importedInFile(base64, hexPath)
+	if hex == nil || !astutil.PackageUsedElsewhere(info, base64) {
+		return mutator.Mutation{}, false
+	}
+
+	original := n.Fun
+	mutated := &ast.SelectorExpr{
+		X:   ast.NewIdent(hex.Name()),
+		Sel: ast.NewIdent(sel.Sel.Name),
+	}
+
+	return mutator.Mutation{
+		Change: func() {
+			n.Fun = mutated
+		},
+		Reset: func() {
+			n.Fun = original
+		},
+	}, true
+}
+
+// importedInFile returns the package name of the given import path in the file of the given package name.
+func importedInFile(pkgName *types.PkgName, path string) *types.PkgName {
+	scope := pkgName.Parent()
+	if scope == nil {
+		return nil
+	}
+
+	for _, name := range scope.Names() {
+		if other, ok := scope.Lookup(name).(*types.PkgName); ok && other.Imported().Path() == path {
+			return other
+		}
+	}
+
+	return nil
+}
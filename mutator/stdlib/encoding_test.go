@@ -0,0 +1,16 @@
+package stdlib
+
+import (
+	"testing"
+
+	"github.com/VirtualRoyalty/go-mutesting/test"
+)
+
+func TestMutatorEncoding(t *testing.T) {
+	test.Mutator(
+		t,
+		MutatorEncoding,
+		"../../testdata/stdlib/encoding.go",
+		8,
+	)
+}
@@ -0,0 +1,95 @@
+package stdlib
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/VirtualRoyalty/go-mutesting/astutil"
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("stdlib/minmax", MutatorMinMax)
+}
+
+const mathPath = "math"
+
+var minMaxMutations = map[string]string{
+	"min": "max",
+	"max": "min",
+	"Min": "Max",
+	"Max": "Min",
+}
+
+// MutatorMinMax implements a mutator to swap the min and max builtins as well as math.Min and math.Max, e.g. of clamping and limiting logic.
+// Builtins are only swapped if the other builtin is not shadowed at the call.
+func MutatorMinMax(pkg *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	if info == nil {
+		return nil
+	}
+
+	n, ok := node.(*ast.CallExpr)
+	if !ok {
+		return nil
+	}
+
+	switch fun := n.Fun.(type) {
+	case *ast.Ident:
+		builtin, ok := info.Uses[fun].(*types.Builtin)
+		if !ok || (builtin.Name() != "min" && builtin.Name() != "max") {
+			return nil
+		}
+
+		mutated := minMaxMutations[fun.Name]
+		if !isBuiltinAt(pkg, n, mutated) {
+			return nil
+		}
+
+		return []mutator.Mutation{
+			{
+				Change: func() {
+					n.Fun = ast.NewIdent(mutated)
+				},
+				Reset: func() {
+					n.Fun = fun
+				},
+			},
+		}
+	case *ast.SelectorExpr:
+		if astutil.PackagePath(info, fun.X) != mathPath || (fun.Sel.Name != "Min" && fun.Sel.Name != "Max") {
+			return nil
+		}
+
+		original := fun.Sel
+		mutated := minMaxMutations[fun.Sel.Name]
+
+		return []mutator.Mutation{
+			{
+				Change: func() {
+					fun.Sel = ast.NewIdent(mutated)
+				},
+				Reset: func() {
+					fun.Sel = original
+				},
+			},
+		}
+	}
+
+	return nil
+}
+
+// isBuiltinAt returns true if the name refers to the builtin of the same name at the call.
+func isBuiltinAt(pkg *types.Package, call *ast.CallExpr, name string) bool {
+	if pkg == nil {
+		return false
+	}
+
+	scope := pkg.Scope().Innermost(call.Pos())
+	if scope == nil {
+		return false
+	}
+
+	_, obj := scope.LookupParent(name, call.Pos())
+
+	return obj == types.Universe.Lookup(name)
+}
@@ -0,0 +1,16 @@
+package stdlib
+
+import (
+	"testing"
+
+	"github.com/VirtualRoyalty/go-mutesting/test"
+)
+
+func TestMutatorMinMax(t *testing.T) {
+	test.Mutator(
+		t,
+		MutatorMinMax,
+		"../../testdata/stdlib/minmax.go",
+		4,
+	)
+}
@@ -0,0 +1,114 @@
+package stdlib
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/VirtualRoyalty/go-mutesting/astutil"
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("stdlib/regexp", MutatorRegexp)
+}
+
+const regexpPath = "regexp"
+
+// regexpFunctions are the functions of the regexp package whose first argument is a pattern.
+var regexpFunctions = map[string]struct{}{
+	"Compile":          {},
+	"CompilePOSIX":     {},
+	"Match":            {},
+	"MatchString":      {},
+	"MustCompile":      {},
+	"MustCompilePOSIX": {},
+}
+
+// MutatorRegexp implements a mutator for literal patterns of the regexp package.
+// The anchors ^ and $ at the start and the end of a pattern are removed and every + quantifier is replaced by *, mutated patterns which do not compile are skipped.
+func MutatorRegexp(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	if info == nil {
+		return nil
+	}
+
+	n, ok := node.(*ast.CallExpr)
+	if !ok || len(n.Args) == 0 {
+		return nil
+	}
+
+	sel, ok := n.Fun.(*ast.SelectorExpr)
+	if !ok || astutil.PackagePath(info, sel.X) != regexpPath {
+		return nil
+	} else if _, ok := regexpFunctions[sel.Sel.Name]; !ok {
+		return nil
+	}
+
+	lit, ok := n.Args[0].(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return nil
+	}
+
+	pattern, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return nil
+	}
+
+	var mutations []mutator.Mutation
+
+	for _, mutated := range regexpMutations(pattern) {
+		if _, err := regexp.Compile(mutated); err != nil {
+			continue
+		}
+
+		value := strconv.Quote(mutated)
+		if strings.HasPrefix(lit.Value, "`") && !strings.Contains(mutated, "`") {
+			value = "`" + mutated + "`"
+		}
+		original := lit.Value
+
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				lit.Value = value
+			},
+			Reset: func() {
+				lit.Value = original
+			},
+		})
+	}
+
+	return mutations
+}
+
+// regexpMutations returns the mutated patterns of the given pattern.
+func regexpMutations(pattern string) []string {
+	var mutated []string
+
+	if strings.HasPrefix(pattern, "^") {
+		mutated = append(mutated, pattern[1:])
+	}
+
+	escaped := false
+	class := false
+	for i, c := range pattern {
+		switch {
+		case escaped:
+			escaped = false
+		case c == '\\':
+			escaped = true
+		case c == '[':
+			class = true
+		case c == ']':
+			class = false
+		case c == '+' && !class && i > 0:
+			mutated = append(mutated, pattern[:i]+"*"+pattern[i+1:])
+		case c == '$' && !class && i == len(pattern)-1:
+			mutated = append(mutated, pattern[:i])
+		}
+	}
+
+	return mutated
+}
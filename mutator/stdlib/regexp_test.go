@@ -0,0 +1,16 @@
+package stdlib
+
+import (
+	"testing"
+
+	"github.com/VirtualRoyalty/go-mutesting/test"
+)
+
+func TestMutatorRegexp(t *testing.T) {
+	test.Mutator(
+		t,
+		MutatorRegexp,
+		"../../testdata/stdlib/regexp.go",
+		7,
+	)
+}
@@ -0,0 +1,118 @@
+package stdlib
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"github.com/VirtualRoyalty/go-mutesting/astutil"
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.RegisterConfigurable("stdlib/sanitize", NewMutatorSanitize)
+}
+
+// defaultSanitizeFunctions are the sanitization functions which are removed if the "functions" parameter is not set.
+var defaultSanitizeFunctions = []string{
+	"html.EscapeString",
+	"net/url.QueryEscape",
+	"path/filepath.Clean",
+	"strings.TrimSpace",
+}
+
+var defaultSanitize = newSanitizeMutator(sanitizeFunctionSet(defaultSanitizeFunctions))
+
+// MutatorSanitize implements a mutator to remove calls of sanitization functions whose result is used further,
+// e.g. strings.TrimSpace(s) is replaced by s, which checks whether the tests cover injection and normalization handling.
+func MutatorSanitize(pkg *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	return defaultSanitize(pkg, info, node)
+}
+
+// NewMutatorSanitize returns a mutator to remove calls of the sanitization functions of the "functions" parameter,
+// which are given by their import path and name, e.g. "net/url.QueryEscape".
+func NewMutatorSanitize(config mutator.Config) (mutator.Mutator, error) {
+	err := config.Check("functions")
+	if err != nil {
+		return nil, err
+	}
+
+	functions, err := config.Strings("functions", defaultSanitizeFunctions)
+	if err != nil {
+		return nil, err
+	}
+	for _, function := range functions {
+		if i := strings.LastIndex(function, "."); i <= 0 || i == len(function)-1 {
+			return nil, fmt.Errorf("function %q is not given by its import path and name, e.g. \"strings.TrimSpace\"", function)
+		}
+	}
+
+	return newSanitizeMutator(sanitizeFunctionSet(functions)), nil
+}
+
+func sanitizeFunctionSet(functions []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(functions))
+	for _, function := range functions {
+		set[function] = struct{}{}
+	}
+
+	return set
+}
+
+func newSanitizeMutator(functions map[string]struct{}) mutator.Mutator {
+	return func(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+		if info == nil {
+			return nil
+		}
+
+		// Only the calls whose result feeds further processing are mutated, so the call is replaced in its parent
+		var mutations []mutator.Mutation
+		for _, x := range astutil.ValueExprs(node) {
+			if mutation, ok := mutateSanitizeCall(functions, info, x); ok {
+				mutations = append(mutations, mutation)
+			}
+		}
+
+		return mutations
+	}
+}
+
+// mutateSanitizeCall replaces the expression if it is a call of a sanitization function with its argument,
+// if the argument has the type of the result and the package of the function is still used after the mutation.
+func mutateSanitizeCall(functions map[string]struct{}, info *types.Info, x *ast.Expr) (mutator.Mutation, bool) {
+	call, ok := (*x).(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 || call.Ellipsis.IsValid() {
+		return mutator.Mutation{}, false
+	}
+
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return mutator.Mutation{}, false
+	}
+
+	path := astutil.PackagePath(info, sel.X)
+	if _, ok := functions[path+"."+sel.Sel.Name]; path == "" || !ok {
+		return mutator.Mutation{}, false
+	}
+
+	argType, resultType := info.TypeOf(call.Args[0]), info.TypeOf(call)
+	if argType == nil || resultType == nil || !types.Identical(types.Default(argType), resultType) {
+		return mutator.Mutation{}, false
+	}
+	if !astutil.PackageUsedElsewhere(info, info.Uses[sel.X.(*ast.Ident)].(*types.PkgName)) {
+		return mutator.Mutation{}, false
+	}
+
+	original := *x
+	mutated := call.Args[0]
+
+	return mutator.Mutation{
+		Change: func() {
+			*x = mutated
+		},
+		Reset: func() {
+			*x = original
+		},
+	}, true
+}
@@ -0,0 +1,41 @@
+package stdlib
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/VirtualRoyalty/go-mutesting"
+	"github.com/VirtualRoyalty/go-mutesting/internal/parser"
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+	"github.com/VirtualRoyalty/go-mutesting/test"
+)
+
+func TestMutatorSanitize(t *testing.T) {
+	test.Mutator(
+		t,
+		MutatorSanitize,
+		"../../testdata/stdlib/sanitize.go",
+		6,
+	)
+}
+
+func TestNewMutatorSanitize(t *testing.T) {
+	src, _, pkg, info, err := parser.ParseAndTypeCheckFile("../../testdata/stdlib/sanitize.go", "", nil)
+	assert.NoError(t, err)
+
+	for count, functions := range map[int][]interface{}{
+		2: {"strings.TrimSpace"},
+		3: {"strings.ToUpper", "path/filepath.Clean"},
+		0: {},
+	} {
+		m, err := NewMutatorSanitize(mutator.Config{"functions": functions})
+		assert.NoError(t, err)
+		assert.Equal(t, count, mutesting.CountWalk(pkg, info, src, m), functions)
+	}
+
+	for _, config := range []mutator.Config{{"functions": "strings.TrimSpace"}, {"functions": []string{"TrimSpace"}}, {"function": []string{"strings.TrimSpace"}}} {
+		_, err := NewMutatorSanitize(config)
+		assert.Error(t, err, config)
+	}
+}
@@ -0,0 +1,77 @@
+package mutesting
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// patchIndexFileName is the file name of the index of all written patches.
+const patchIndexFileName = "index.json"
+
+// patchEntry describes one written patch in the index.
+type patchEntry struct {
+	Patch             string `json:"patch"`
+	Checksum          string `json:"checksum"`
+	MutatorName       string `json:"mutatorName"`
+	OriginalFilePath  string `json:"originalFilePath"`
+	OriginalStartLine int64  `json:"originalStartLine"`
+	Package           string `json:"package"`
+}
+
+// patchWriter writes every mutation as a patch which can be applied with "git apply" instead of executing it.
+type patchWriter struct {
+	dir     string
+	entries []patchEntry
+}
+
+func newPatchWriter(dir string) (*patchWriter, error) {
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return nil, err
+	}
+
+	return &patchWriter{
+		dir:     dir,
+		entries: []patchEntry{},
+	}, nil
+}
+
+// write writes the patch of the mutation of the given file.
+func (w *patchWriter) write(mutatorName string, pkg string, originalFile string, mutationFile string, checksum string, startLine int64) error {
+	file := filepath.ToSlash(originalFile)
+
+	diff, err := diffMutation(originalFile, mutationFile, "a/"+file, "b/"+file)
+	if err != nil {
+		return fmt.Errorf("could not diff mutation %q: %v", mutationFile, err)
+	}
+
+	patch := checksum + ".patch"
+
+	err = os.WriteFile(filepath.Join(w.dir, patch), diff, 0666)
+	if err != nil {
+		return err
+	}
+
+	w.entries = append(w.entries, patchEntry{
+		Patch:             patch,
+		Checksum:          checksum,
+		MutatorName:       mutatorName,
+		OriginalFilePath:  originalFile,
+		OriginalStartLine: startLine,
+		Package:           pkg,
+	})
+
+	return nil
+}
+
+// close writes the index of all written patches.
+func (w *patchWriter) close() error {
+	content, err := json.MarshalIndent(w.entries, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(w.dir, patchIndexFileName), content, 0666)
+}
@@ -0,0 +1,43 @@
+//go:build !windows
+
+package mutesting
+
+import (
+	"os/exec"
+	"strconv"
+	"syscall"
+)
+
+// setProcessGroup starts the command in its own process group, so it can be killed together with its children.
+func setProcessGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+}
+
+// killProcessGroup kills the process group of the started command.
+func killProcessGroup(cmd *exec.Cmd) error {
+	return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+}
+
+// checkNice checks that the command can be executed with a lower scheduling priority.
+func checkNice(nice int) error {
+	if nice == 0 {
+		return nil
+	}
+
+	_, err := exec.LookPath("nice")
+
+	return err
+}
+
+// setNice executes the command with the "nice" command, so the command and all its children have a lower scheduling priority.
+func setNice(cmd *exec.Cmd, nice int) {
+	path, err := exec.LookPath("nice")
+	if err != nil {
+		cmd.Err = err
+
+		return
+	}
+
+	cmd.Args = append([]string{"nice", "-n", strconv.Itoa(nice), cmd.Path}, cmd.Args[1:]...)
+	cmd.Path = path
+}
@@ -0,0 +1,32 @@
+//go:build windows
+
+package mutesting
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// setProcessGroup does nothing since process groups can not be killed as a whole on Windows.
+func setProcessGroup(cmd *exec.Cmd) {}
+
+// killProcessGroup kills the process of the started command.
+func killProcessGroup(cmd *exec.Cmd) error {
+	return cmd.Process.Kill()
+}
+
+// belowNormalPriorityClass is the BELOW_NORMAL_PRIORITY_CLASS process creation flag.
+const belowNormalPriorityClass = 0x00004000
+
+// checkNice does nothing since every process can lower its priority on Windows.
+func checkNice(nice int) error {
+	return nil
+}
+
+// setNice starts the command with a below normal priority which is inherited by its children, the nice level itself is ignored.
+func setNice(cmd *exec.Cmd, nice int) {
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.CreationFlags |= belowNormalPriorityClass
+}
@@ -0,0 +1,158 @@
+package mutesting
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"runtime/pprof"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Phases of the engine whose time is summarized by --profile-engine
+const (
+	// phaseLoading parses and type-checks the mutated files
+	phaseLoading = "loading"
+	// phaseGeneration walks the ASTs and applies the mutators
+	phaseGeneration = "generation"
+	// phasePrinting prints the mutated ASTs as source code
+	phasePrinting = "printing"
+	// phaseHashing computes the checksums of the mutations and finds duplicates
+	phaseHashing = "hashing"
+	// phaseWriting writes the mutations and their diffs
+	phaseWriting = "writing"
+)
+
+// enginePhases are the phases of the engine in the order of the summary.
+var enginePhases = []string{phaseLoading, phaseGeneration, phasePrinting, phaseHashing, phaseWriting}
+
+// engineProfiler writes pprof profiles of go-mutesting itself and measures the time of the phases of the engine separately from the time of the executed tests.
+// All methods can be called on a nil profiler which does nothing.
+type engineProfiler struct {
+	cpu     *os.File
+	memPath string
+	start   time.Time
+
+	mutex  sync.Mutex
+	phases map[string]time.Duration
+	tests  time.Duration
+}
+
+// newEngineProfiler starts the profiles of the given argument of --profile-engine, which is the CPU profile file optionally followed by a comma and the memory profile file.
+// Either file can be empty, e.g. ",mem.out" writes only the memory profile.
+func newEngineProfiler(files string) (*engineProfiler, error) {
+	parts := strings.Split(files, ",")
+	if len(parts) > 2 {
+		return nil, fmt.Errorf("Engine profiles %q are not of the form cpu-file[,mem-file]", files)
+	}
+
+	p := &engineProfiler{
+		start:  time.Now(),
+		phases: map[string]time.Duration{},
+	}
+	if len(parts) == 2 {
+		p.memPath = strings.TrimSpace(parts[1])
+	}
+
+	if cpuPath := strings.TrimSpace(parts[0]); cpuPath != "" {
+		f, err := os.Create(cpuPath)
+		if err != nil {
+			return nil, fmt.Errorf("Could not create CPU profile %q: %v", cpuPath, err)
+		}
+
+		err = pprof.StartCPUProfile(f)
+		if err != nil {
+			_ = f.Close()
+
+			return nil, fmt.Errorf("Could not start CPU profile: %v", err)
+		}
+
+		p.cpu = f
+	}
+
+	return p, nil
+}
+
+// track measures the given phase until the returned function is called.
+func (p *engineProfiler) track(phase string) func() {
+	if p == nil {
+		return func() {}
+	}
+
+	start := time.Now()
+
+	return func() {
+		p.mutex.Lock()
+		p.phases[phase] += time.Since(start)
+		p.mutex.Unlock()
+	}
+}
+
+// tested records the duration of the exec command of a mutation.
+func (p *engineProfiler) tested(duration time.Duration) {
+	if p == nil {
+		return
+	}
+
+	p.mutex.Lock()
+	p.tests += duration
+	p.mutex.Unlock()
+}
+
+// stop stops the CPU profile and writes the memory profile.
+func (p *engineProfiler) stop() error {
+	if p == nil {
+		return nil
+	}
+
+	if p.cpu != nil {
+		pprof.StopCPUProfile()
+
+		err := p.cpu.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	if p.memPath != "" {
+		f, err := os.Create(p.memPath)
+		if err != nil {
+			return err
+		}
+
+		// The heap profile holds only the allocations until the last garbage collection
+		runtime.GC()
+
+		err = pprof.WriteHeapProfile(f)
+		if err != nil {
+			_ = f.Close()
+
+			return err
+		}
+
+		return f.Close()
+	}
+
+	return nil
+}
+
+// summary returns where the time of the run went, the time of the tests is summed up over all workers.
+func (p *engineProfiler) summary() string {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	var engine time.Duration
+	phases := make([]string, len(enginePhases))
+	for i, phase := range enginePhases {
+		engine += p.phases[phase]
+		phases[i] = fmt.Sprintf("%s %s", phase, p.phases[phase].Round(time.Millisecond))
+	}
+
+	return fmt.Sprintf("The engine took %s (%s) and the tests took %s of the run of %s",
+		engine.Round(time.Millisecond),
+		strings.Join(phases, ", "),
+		p.tests.Round(time.Millisecond),
+		time.Since(p.start).Round(time.Millisecond),
+	)
+}
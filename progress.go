@@ -0,0 +1,141 @@
+package mutesting
+
+import (
+	"encoding/json"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/VirtualRoyalty/go-mutesting/internal/models"
+)
+
+// progress is the content of the progress file.
+type progress struct {
+	// Files is the count of files which are mutated, files which are skipped since they are too large are not counted
+	Files int `json:"files"`
+	// MutatedFiles is the count of files whose mutations were generated so far
+	MutatedFiles int `json:"mutatedFiles"`
+	// Total is the count of the mutations of the files which were mutated so far
+	Total int `json:"total"`
+	// Executed is the count of mutations with a verdict, mutations which are ignored, e.g. by --sample-rate, are counted as well
+	Executed    int    `json:"executed"`
+	Killed      int64  `json:"killed"`
+	Escaped     int64  `json:"escaped"`
+	CurrentFile string `json:"currentFile,omitempty"`
+	Elapsed     int64  `json:"elapsedSeconds"`
+	// ETA is the estimated count of seconds until all mutations are executed, it is 0 until the first mutation is executed
+	ETA  int64 `json:"etaSeconds"`
+	Done bool  `json:"done"`
+}
+
+// progressWriter writes the progress of the run into a JSON file after every change so external tools can poll it.
+// All methods can be called on a nil writer which does nothing.
+type progressWriter struct {
+	path  string
+	start time.Time
+
+	mutex    sync.Mutex
+	progress progress
+}
+
+func newProgressWriter(path string, files int) (*progressWriter, error) {
+	w := &progressWriter{
+		path:  path,
+		start: time.Now(),
+		progress: progress{
+			Files: files,
+		},
+	}
+
+	return w, w.write()
+}
+
+// excludeFile records a file which is not mutated.
+func (w *progressWriter) excludeFile() {
+	w.update(func(p *progress) {
+		p.Files--
+	})
+}
+
+// startFile records that the mutations of the given file are generated next.
+func (w *progressWriter) startFile(file string, mutations int) {
+	w.update(func(p *progress) {
+		p.MutatedFiles++
+		p.Total += mutations
+		p.CurrentFile = file
+	})
+}
+
+// ignore records a mutation which is not executed.
+func (w *progressWriter) ignore() {
+	w.update(func(p *progress) {
+		p.Executed++
+	})
+}
+
+// collect records an executed mutation with the current stats of the report.
+func (w *progressWriter) collect(report *models.Report) {
+	w.update(func(p *progress) {
+		p.Executed++
+		p.Killed = report.Stats.KilledCount
+		p.Escaped = report.Stats.EscapedCount
+	})
+}
+
+// finish records that all mutations are executed.
+func (w *progressWriter) finish() {
+	w.update(func(p *progress) {
+		p.CurrentFile = ""
+		p.Done = true
+	})
+}
+
+func (w *progressWriter) update(change func(p *progress)) {
+	if w == nil {
+		return
+	}
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	change(&w.progress)
+
+	err := w.write()
+	if err != nil {
+		log.Printf("Error writing progress file: %s", err)
+	}
+}
+
+func (w *progressWriter) write() error {
+	elapsed := time.Since(w.start)
+
+	p := &w.progress
+	p.Elapsed = int64(elapsed.Seconds())
+	p.ETA = 0
+	if !p.Done && p.Executed > 0 {
+		remaining := float64(p.Total - p.Executed)
+		if p.MutatedFiles > 0 && p.Files > p.MutatedFiles {
+			// The files which are not mutated yet are assumed to have as many mutations as the mutated files on average
+			remaining += float64(p.Total) / float64(p.MutatedFiles) * float64(p.Files-p.MutatedFiles)
+		}
+		if remaining > 0 {
+			p.ETA = int64(elapsed.Seconds() / float64(p.Executed) * remaining)
+		}
+	}
+
+	content, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+
+	// The progress is replaced at once so pollers never read a partially written file
+	tmp := filepath.Join(filepath.Dir(w.path), "."+filepath.Base(w.path)+".tmp")
+	err = os.WriteFile(tmp, content, 0666)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, w.path)
+}
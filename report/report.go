@@ -0,0 +1,144 @@
+// Package report reads, writes and compares the artifacts of go-mutesting, which are JSON reports and blacklists of mutation checksums.
+// CI bots and dashboards can use it instead of re-implementing these formats.
+package report
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/VirtualRoyalty/go-mutesting/internal/models"
+)
+
+// Types of the JSON report
+type (
+	// Report is the JSON report of a run
+	Report = models.Report
+	// Stats are the counts and scores of the mutations of a run, file or function
+	Stats = models.Stats
+	// FunctionStats are the stats of the mutations of one function
+	FunctionStats = models.FunctionStats
+	// Mutant is a mutation with its diff
+	Mutant = models.Mutant
+	// Mutator describes the mutator and the position of a mutant
+	Mutator = models.Mutator
+	// MutantReference identifies a mutant by its checksum, mutator and position
+	MutantReference = models.MutantReference
+	// Duplicate is a mutant with the same source code as another mutant
+	Duplicate = models.Duplicate
+	// ExcludedFile is a file which was not mutated since it is too large
+	ExcludedFile = models.ExcludedFile
+	// HistoryEntry is the mutation score and counts of one run in a history file
+	HistoryEntry = models.HistoryEntry
+)
+
+// Load reads the JSON report of the given file.
+func Load(path string) (*Report, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{}
+	err = json.Unmarshal(content, report)
+	if err != nil {
+		return nil, fmt.Errorf("could not read report %q: %v", path, err)
+	}
+
+	return report, nil
+}
+
+// Save writes the report as JSON into the given file.
+func Save(path string, report *Report) error {
+	content, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, content, 0666)
+}
+
+// Difference of the escaped mutants of two reports
+type Difference struct {
+	// Escaped are the mutants which escaped in the new report but not in the old report
+	Escaped []Mutant `json:"escaped"`
+	// Killed are the mutants which escaped in the old report and were caught in the new report
+	Killed []Mutant `json:"killed"`
+	// MsiDelta is the mutation score of the new report minus the mutation score of the old report
+	MsiDelta float64 `json:"msiDelta"`
+}
+
+// Diff compares the old report a with the new report b.
+// Mutants are identified by their file and their mutated source code, so mutants of changed files are treated as different mutants.
+// Escaped mutants of a which are not part of b at all are neither escaped nor killed.
+func Diff(a, b *Report) *Difference {
+	escaped := map[string]bool{}
+	for _, mutant := range a.Escaped {
+		escaped[mutantKey(mutant)] = true
+	}
+
+	d := &Difference{
+		MsiDelta: b.Stats.Msi - a.Stats.Msi,
+	}
+
+	for _, mutant := range b.Escaped {
+		if !escaped[mutantKey(mutant)] {
+			d.Escaped = append(d.Escaped, mutant)
+		}
+	}
+
+	for _, caught := range [][]Mutant{b.Killed, b.Timeouted, b.Errored, b.CaughtByVet} {
+		for _, mutant := range caught {
+			if escaped[mutantKey(mutant)] {
+				d.Killed = append(d.Killed, mutant)
+			}
+		}
+	}
+
+	return d
+}
+
+func mutantKey(mutant Mutant) string {
+	return mutant.Mutator.OriginalFilePath + "\x00" + mutant.Mutator.MutatedSourceCode
+}
+
+// LoadHistory reads the entries of a history file which is written with --history-file.
+func LoadHistory(path string) ([]HistoryEntry, error) {
+	return models.ReadHistory(path)
+}
+
+// LoadBlacklist reads the checksums of a blacklist file, which are given to go-mutesting with --blacklist to suppress their mutations.
+// Every checksum is on its own line, empty lines are ignored.
+func LoadBlacklist(path string) ([]string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var checksums []string
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		checksums = append(checksums, line)
+	}
+
+	return checksums, scanner.Err()
+}
+
+// SaveBlacklist writes the checksums into a blacklist file, every checksum ends with a new line character.
+func SaveBlacklist(path string, checksums []string) error {
+	var b strings.Builder
+	for _, checksum := range checksums {
+		b.WriteString(checksum)
+		b.WriteString("\n")
+	}
+
+	return os.WriteFile(path, []byte(b.String()), 0666)
+}
@@ -0,0 +1,83 @@
+package report
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func mutant(file string, mutatedSourceCode string) Mutant {
+	m := Mutant{}
+	m.Mutator.OriginalFilePath = file
+	m.Mutator.MutatedSourceCode = mutatedSourceCode
+
+	return m
+}
+
+func TestLoadSave(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report.json")
+
+	r := &Report{
+		Stats:   Stats{KilledCount: 1, EscapedCount: 1, Msi: 0.5},
+		Escaped: []Mutant{mutant("a.go", "package a // escaped")},
+		Killed:  []Mutant{mutant("a.go", "package a // killed")},
+	}
+	assert.NoError(t, Save(path, r))
+
+	loaded, err := Load(path)
+	assert.NoError(t, err)
+	assert.Equal(t, r, loaded)
+
+	assert.NoError(t, os.WriteFile(path, []byte("{"), 0666))
+	_, err = Load(path)
+	assert.Error(t, err)
+
+	_, err = Load(filepath.Join(t.TempDir(), "unknown.json"))
+	assert.Error(t, err)
+}
+
+func TestDiff(t *testing.T) {
+	a := &Report{
+		Stats: Stats{Msi: 0.5},
+		Escaped: []Mutant{
+			mutant("a.go", "fixed"),
+			mutant("a.go", "still escaped"),
+			mutant("a.go", "removed"),
+		},
+	}
+	b := &Report{
+		Stats: Stats{Msi: 0.75},
+		Escaped: []Mutant{
+			mutant("a.go", "still escaped"),
+			mutant("b.go", "fixed"),
+		},
+		Killed:    []Mutant{mutant("a.go", "fixed")},
+		Timeouted: []Mutant{mutant("b.go", "still escaped")},
+	}
+
+	d := Diff(a, b)
+	assert.Equal(t, []Mutant{mutant("b.go", "fixed")}, d.Escaped)
+	assert.Equal(t, []Mutant{mutant("a.go", "fixed")}, d.Killed)
+	assert.Equal(t, 0.25, d.MsiDelta)
+
+	assert.Equal(t, &Difference{}, Diff(b, b))
+}
+
+func TestBlacklist(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "blacklist")
+
+	checksums := []string{"a47bbde18f8e8e7fe159ce6456d4e7aa", "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"}
+	assert.NoError(t, SaveBlacklist(path, checksums))
+
+	content, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, checksums[0]+"\n"+checksums[1]+"\n", string(content))
+
+	assert.NoError(t, os.WriteFile(path, []byte("\n"+checksums[0]+"\r\n\n"+checksums[1]), 0666))
+
+	loaded, err := LoadBlacklist(path)
+	assert.NoError(t, err)
+	assert.Equal(t, checksums, loaded)
+}
@@ -0,0 +1,1297 @@
+package mutesting
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/token"
+	"go/types"
+	"hash/fnv"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/VirtualRoyalty/osutil"
+	"github.com/jessevdk/go-flags"
+
+	"github.com/VirtualRoyalty/go-mutesting/astutil"
+	"github.com/VirtualRoyalty/go-mutesting/internal/annotation"
+	"github.com/VirtualRoyalty/go-mutesting/internal/console"
+	"github.com/VirtualRoyalty/go-mutesting/internal/coverage"
+	"github.com/VirtualRoyalty/go-mutesting/internal/filter"
+	"github.com/VirtualRoyalty/go-mutesting/internal/gitdiff"
+	"github.com/VirtualRoyalty/go-mutesting/internal/impact"
+	"github.com/VirtualRoyalty/go-mutesting/internal/importing"
+	"github.com/VirtualRoyalty/go-mutesting/internal/models"
+	"github.com/VirtualRoyalty/go-mutesting/internal/parser"
+	"github.com/VirtualRoyalty/go-mutesting/internal/plugin"
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+	"github.com/VirtualRoyalty/go-mutesting/report"
+)
+
+// Options configure a run just like the arguments and the config file of the go-mutesting command.
+type Options = models.Options
+
+// Report is the result of a run which is written as report.json by the go-mutesting command.
+type Report = models.Report
+
+// Mutant is a mutation of a file together with its verdict.
+type Mutant = models.Mutant
+
+// Verdict is the result of the execution of a mutant.
+type Verdict string
+
+// Verdicts of the mutants which are passed to the OnMutant hook of a Runner
+const (
+	// VerdictKilled means that the tests failed for the mutant
+	VerdictKilled Verdict = "killed"
+	// VerdictEscaped means that the tests passed for the mutant
+	VerdictEscaped Verdict = "escaped"
+	// VerdictCaughtByVet means that go vet reported the mutant, see Options.Exec.Vet
+	VerdictCaughtByVet Verdict = "caughtByVet"
+	// VerdictSkipped means that the mutant did not compile
+	VerdictSkipped Verdict = "skipped"
+	// VerdictTimeout means that the tests of the mutant did not finish in time
+	VerdictTimeout Verdict = "timeout"
+	// VerdictError means that the exec command exited with an unknown exit code
+	VerdictError Verdict = "error"
+	// VerdictNotCovered means that the mutant was not executed since no test covers it
+	VerdictNotCovered Verdict = "notCovered"
+	// VerdictDuplicated means that the mutant was not executed since it is the same as an earlier mutant
+	VerdictDuplicated Verdict = "duplicated"
+)
+
+// ErrNoFiles is returned by a run if there is no file to mutate, e.g. since no file is changed.
+var ErrNoFiles = errors.New("Could not find any suitable Go source files")
+
+// Runner executes the mutation testing of Go source files with the mutators which are registered in the mutator package.
+// The mutators register themselves when their packages are imported, e.g.
+//
+//	import _ "github.com/VirtualRoyalty/go-mutesting/mutator/arithmetic"
+type Runner struct {
+	// Options configure the run, the Remaining.Targets are the mutated packages, directories and files
+	Options *Options
+	// Mutate writes a patch for every mutation into the Mutate.Out directory of the options instead of executing it
+	Mutate bool
+	// Output receives the console output, which is rendered according to the General.Console option, by default it is os.Stdout
+	Output io.Writer
+	// Renderer renders the console output instead of a renderer of the General.Console option for the Output, e.g. to render further output of the caller with the same renderer
+	Renderer Renderer
+	// OnMutant is called for every mutant with its verdict, the calls are sequential even with multiple workers
+	OnMutant func(mutant Mutant, verdict Verdict)
+
+	matrix *models.KillMatrix
+}
+
+// Renderer renders the console output of a run.
+type Renderer = console.Renderer
+
+// NewOptions returns options with the defaults of the go-mutesting command.
+func NewOptions() *Options {
+	opts := &Options{}
+
+	_, err := flags.NewParser(opts, flags.None).ParseArgs(nil)
+	if err != nil {
+		panic(err)
+	}
+
+	return opts
+}
+
+// NewRunner returns a runner with the given options.
+func NewRunner(opts *Options) *Runner {
+	return &Runner{
+		Options: opts,
+	}
+}
+
+// KillMatrix returns the kill matrix of the last run or nil if it was not recorded, see Options.Report.ExportMatrix.
+func (r *Runner) KillMatrix() *models.KillMatrix {
+	return r.matrix
+}
+
+// Files returns the files which are mutated by a run.
+func (r *Runner) Files() ([]string, error) {
+	files, _, err := r.files()
+
+	return files, err
+}
+
+func (r *Runner) files() ([]string, *gitdiff.Changes, error) {
+	opts := r.Options
+
+	for _, glob := range append(append([]string{}, opts.Files.Exclude...), opts.Config.Exclude...) {
+		if err := importing.CheckGlob(glob); err != nil {
+			return nil, nil, fmt.Errorf("Could not exclude files: %v", err)
+		}
+	}
+
+	files := importing.FilesOfArgs(opts.Remaining.Targets, opts)
+
+	var changes *gitdiff.Changes
+	if opts.Filter.GitDiff != "" && opts.Filter.Staged {
+		return nil, nil, fmt.Errorf("Could not get changes: --git-diff and --staged can not be combined")
+	} else if opts.Filter.GitDiff != "" {
+		var err error
+		changes, err = gitdiff.Diff(opts.Filter.GitDiff)
+		if err != nil {
+			return nil, nil, fmt.Errorf("Could not get changes compared to %q: %v", opts.Filter.GitDiff, err)
+		}
+	} else if opts.Filter.Staged {
+		var err error
+		changes, err = gitdiff.Staged()
+		if err != nil {
+			return nil, nil, fmt.Errorf("Could not get staged changes: %v", err)
+		}
+	} else if opts.Filter.ChangedFunctions {
+		return nil, nil, fmt.Errorf("The changed functions of --changed-functions need --git-diff or --staged")
+	}
+	if changes != nil {
+		files = changes.Files(files)
+	}
+
+	if len(files) == 0 {
+		return nil, nil, ErrNoFiles
+	}
+
+	return files, changes, nil
+}
+
+// Run mutates the files and executes the mutations, the report is nil if only patches were written or mutations were printed by --dry-run.
+// Mutations which are not executed yet when the context is done are not executed anymore.
+func (r *Runner) Run(ctx context.Context) (*Report, error) {
+	// The options of the caller are not changed, e.g. the mutate command does not execute the mutations
+	opts := *r.Options
+
+	output := r.Output
+	if output == nil {
+		output = os.Stdout
+	}
+	renderer := r.Renderer
+	if renderer == nil {
+		var err error
+		renderer, err = console.NewRenderer(opts.General.Console, output)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	s := &run{
+		opts:         &opts,
+		console:      console.New(renderer, &opts),
+		report:       &models.Report{},
+		testFiles:    map[string]bool{},
+		filePackages: map[string]string{},
+	}
+
+	if r.Mutate {
+		patches, err := newPatchWriter(opts.Mutate.Out)
+		if err != nil {
+			return nil, fmt.Errorf("Could not create patch directory %q: %v", opts.Mutate.Out, err)
+		}
+		s.patches = patches
+
+		opts.Exec.NoExec = true
+	}
+
+	if opts.Exec.DryRun {
+		if r.Mutate {
+			return nil, fmt.Errorf("The mutate command writes patches, it can not be used with --dry-run")
+		} else if opts.Filter.Baseline != "" {
+			return nil, fmt.Errorf("The mutations of --dry-run are not written, they can not be compared with the --baseline report")
+		}
+
+		s.dryRun = &dryRunPrinter{
+			console: s.console,
+		}
+		opts.Exec.NoExec = true
+	}
+
+	if opts.General.ProfileEngine != "" {
+		profiler, err := newEngineProfiler(opts.General.ProfileEngine)
+		if err != nil {
+			return nil, err
+		}
+		s.profiler = profiler
+
+		defer func() {
+			err := profiler.stop()
+			if err != nil {
+				s.console.Message("Could not write engine profiles %q: %v", opts.General.ProfileEngine, err)
+			}
+
+			s.console.Message(profiler.summary())
+		}()
+	}
+
+	var err error
+	s.files, s.changes, err = r.files()
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.configure()
+	if err != nil {
+		return nil, err
+	}
+
+	defer s.closePlugins()
+	err = s.enableMutators()
+	if err != nil {
+		return nil, err
+	}
+
+	s.tmpDir, err = os.MkdirTemp("", "go-mutesting-")
+	if err != nil {
+		return nil, fmt.Errorf("Could not create temporary directory: %v", err)
+	}
+	defer s.removeTmpDir()
+	s.console.Verbose("Save mutations into %q", s.tmpDir)
+
+	if opts.Test.Selection {
+		selectionDir := filepath.Join(s.tmpDir, "coverage")
+		err = os.MkdirAll(selectionDir, 0755)
+		if err != nil {
+			return nil, fmt.Errorf("Could not create coverage directory %q: %v", selectionDir, err)
+		}
+
+		s.coverage.selector = impact.NewSelector(selectionDir, goBinary(&opts), opts.Files.Tags)
+	}
+
+	if opts.Report.ProgressFile != "" {
+		s.progress, err = newProgressWriter(opts.Report.ProgressFile, len(s.files))
+		if err != nil {
+			return nil, fmt.Errorf("Could not write progress file %q: %v", opts.Report.ProgressFile, err)
+		}
+	}
+
+	s.stream, err = newStreamWriter(opts.Report.Stream, opts.Report.StreamFile, output)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err := s.stream.close()
+		if err != nil {
+			s.console.Message("Could not close stream file %q: %v", opts.Report.StreamFile, err)
+		}
+	}()
+
+	// The execution of mutations is stopped by --fail-fast and --time-budget without canceling the run
+	execCtx, stop := context.WithCancel(ctx)
+	defer stop()
+
+	var stopOnce sync.Once
+	stopped := ""
+	stopExecution := func(reason string) {
+		stopOnce.Do(func() {
+			stopped = reason
+			stop()
+		})
+	}
+
+	if opts.Exec.TimeBudget > 0 {
+		timer := time.AfterFunc(opts.Exec.TimeBudget, func() {
+			stopExecution(models.StoppedByTimeBudget)
+		})
+		defer timer.Stop()
+	}
+
+	onMutant := r.OnMutant
+	if opts.Exec.FailFast {
+		onMutant = func(mutant Mutant, verdict Verdict) {
+			if verdict == VerdictEscaped {
+				stopExecution(models.StoppedByFailFast)
+			}
+
+			if r.OnMutant != nil {
+				r.OnMutant(mutant, verdict)
+			}
+		}
+	}
+
+	s.workers, err = startWorkers(execCtx, s.opts, s.console, s.files, s.tmpDir, s.execs, s.report, s.progress, s.stream, s.profiler, onMutant)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = s.workers.wait()
+	}()
+
+	for _, file := range s.files {
+		if s.workers.ctx.Err() != nil {
+			break
+		}
+
+		err = s.mutateFile(s.workers.ctx, file)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.workers.wait()
+	if err != nil {
+		return nil, err
+	}
+	s.progress.finish()
+	r.matrix = s.workers.matrix
+
+	// The execution is not stopped anymore after all mutations were executed
+	stopOnce.Do(func() {})
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return s.finish(stopped)
+}
+
+// run holds the state of a single run of a Runner.
+type run struct {
+	// opts are a copy of the options of the runner
+	opts    *models.Options
+	console *console.Console
+
+	files    []string
+	changes  *gitdiff.Changes
+	newCode  *gitdiff.Changes
+	baseline *models.Baseline
+
+	checksums *mutationChecksums
+	plugins   map[string]*plugin.Plugin
+	mutators  []mutatorItem
+	execs     []string
+	coverage  *mutationCoverage
+
+	labels          map[string]string
+	pinnedGo        string
+	pinnedGoVersion string
+	maxFileSize     int64
+	match           *regexp.Regexp
+
+	tmpDir   string
+	patches  *patchWriter
+	dryRun   *dryRunPrinter
+	profiler *engineProfiler
+	progress *progressWriter
+	stream   *streamWriter
+	workers  *workerPool
+
+	report *models.Report
+	// testFiles caches for every directory if its package has test files
+	testFiles map[string]bool
+	// filePackages maps the mutated files to the import paths of their packages for the stats of every package
+	filePackages map[string]string
+}
+
+// configure validates the options and reads the files which are referenced by them.
+func (s *run) configure() error {
+	opts := s.opts
+
+	var err error
+	if opts.Report.StrictNewCode != "" {
+		s.newCode, err = gitdiff.Diff(opts.Report.StrictNewCode)
+		if err != nil {
+			return fmt.Errorf("Could not get changes of new code compared to %q: %v", opts.Report.StrictNewCode, err)
+		}
+	}
+
+	s.checksums, err = newMutationChecksums(opts.Files.Checksum)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range opts.Files.Blacklist {
+		blacklist, err := report.LoadBlacklist(f)
+		if err != nil {
+			return fmt.Errorf("Cannot read blacklist file %q: %v", f, err)
+		}
+
+		for _, checksum := range blacklist {
+			err = s.checksums.addBlacklisted(checksum)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if opts.Exec.Exec != "" {
+		s.execs = strings.Split(opts.Exec.Exec, " ")
+	}
+
+	s.coverage = &mutationCoverage{}
+	if opts.Test.Run != "" {
+		s.coverage.run, err = regexp.Compile(opts.Test.Run)
+		if err != nil {
+			return fmt.Errorf("Test run regex is not valid: %v", err)
+		}
+	}
+	if opts.Filter.CoverProfile != "" {
+		s.coverage.profile, err = coverage.ParseProfile(opts.Filter.CoverProfile)
+		if err != nil {
+			return fmt.Errorf("Could not read coverage profile %q: %v", opts.Filter.CoverProfile, err)
+		}
+	}
+	if opts.Exec.Nice < 0 || opts.Exec.Nice > 19 {
+		return fmt.Errorf("Nice level %d is not in the range [0, 19]", opts.Exec.Nice)
+	} else if err := checkNice(opts.Exec.Nice); err != nil {
+		return fmt.Errorf("Could not lower the scheduling priority with --exec-nice: %v", err)
+	}
+
+	if opts.Filter.SampleRate <= 0 || opts.Filter.SampleRate > 1 {
+		return fmt.Errorf("Sample rate %v is not in the range (0, 1]", opts.Filter.SampleRate)
+	}
+
+	if opts.Test.GoTestFlags != "" && (len(s.execs) > 0 || opts.Exec.BuildSystem != "" || opts.Test.Precompile) {
+		return fmt.Errorf("The flags of --gotest-flags are only passed to the go test command of the built-in exec command, they can not be used with --exec, --exec-build-system or --test-precompile")
+	}
+
+	if opts.Exec.Vet && (len(s.execs) > 0 || opts.Exec.BuildSystem != "") {
+		return fmt.Errorf("The go vet of --exec-vet is only executed by the built-in exec command, it can not be used with --exec or --exec-build-system")
+	}
+
+	// A pinned Go command is recorded in the report, so runs with different toolchains can be told apart
+	if opts.Exec.GoBinary != "" || opts.Config.GoBinary != "" {
+		s.pinnedGo = goBinary(opts)
+		s.pinnedGoVersion, err = goVersion(s.pinnedGo)
+		if err != nil {
+			return fmt.Errorf("Could not execute the Go binary %q: %v", s.pinnedGo, err)
+		}
+	}
+
+	s.labels, err = parseLabels(opts.Report.Labels)
+	if err != nil {
+		return err
+	}
+
+	if opts.Filter.SkipFilesOver != "" {
+		s.maxFileSize, err = parseSize(opts.Filter.SkipFilesOver)
+		if err != nil {
+			return fmt.Errorf("File size limit %q is not valid: %v", opts.Filter.SkipFilesOver, err)
+		}
+	}
+
+	if opts.Filter.Match != "" {
+		s.match, err = regexp.Compile(opts.Filter.Match)
+		if err != nil {
+			return fmt.Errorf("Match regex is not valid: %v", err)
+		}
+	}
+
+	if opts.Report.ExportMatrix != "" && !opts.Test.Selection {
+		return fmt.Errorf("The kill matrix of --export-matrix needs the tests of --test-selection")
+	}
+
+	if opts.Filter.Baseline != "" {
+		s.baseline, err = models.ReadBaseline(opts.Filter.Baseline)
+		if err != nil {
+			return fmt.Errorf("Could not read baseline report %q: %v", opts.Filter.Baseline, err)
+		}
+
+		s.console.Verbose("Execute only the %d escaped mutations of the baseline report %q", s.baseline.EscapedCount(), opts.Filter.Baseline)
+	}
+
+	return nil
+}
+
+// enableMutators loads the plugins and enables the mutators according to the options.
+func (s *run) enableMutators() error {
+	opts := s.opts
+
+	for _, path := range opts.Mutator.MutatorPlugins {
+		names, err := plugin.LoadGo(path)
+		if err != nil {
+			return fmt.Errorf("Could not load mutator plugin %q: %v", path, err)
+		}
+
+		s.console.Verbose("Loaded the mutators %s of plugin %q", strings.Join(names, ", "), path)
+	}
+
+	available := mutator.List()
+	s.plugins = map[string]*plugin.Plugin{}
+	for _, config := range opts.Config.Plugins {
+		p, err := plugin.New(config)
+		if err != nil {
+			return fmt.Errorf("Could not load plugin: %v", err)
+		} else if _, err := mutator.New(p.Name()); err == nil || s.plugins[p.Name()] != nil {
+			return fmt.Errorf("Could not load plugin: the mutator %q already exists", p.Name())
+		}
+
+		s.plugins[p.Name()] = p
+		available = append(available, p.Name())
+	}
+
+	for name := range opts.Config.Mutators {
+		if _, ok := s.plugins[name]; ok {
+			return fmt.Errorf("Could not configure mutator %q: plugins have no parameters", name)
+		} else if _, err := mutator.New(name); err != nil {
+			return fmt.Errorf("Could not configure mutator: %v", err)
+		}
+	}
+
+	var packMutators []string
+	if len(opts.Mutator.Packs) > 0 {
+		var err error
+		packMutators, err = mutatorsOfPacks(opts.Config.Packs, opts.Mutator.Packs, available)
+		if err != nil {
+			return fmt.Errorf("Could not enable packs: %v", err)
+		}
+	}
+
+	for _, name := range available {
+		if packMutators != nil {
+			// Packs enable their opt-in mutators as well
+			if !matchMutator(name, packMutators) && !matchMutator(name, opts.Mutator.EnableMutators) {
+				continue
+			}
+		} else if mutator.IsOptIn(name) && !matchMutator(name, opts.Mutator.EnableMutators) {
+			continue
+		}
+		if matchMutator(name, opts.Mutator.DisableMutators) {
+			continue
+		}
+
+		s.console.Verbose("Enable mutator %q", name)
+
+		var m mutator.Mutator
+		if p, ok := s.plugins[name]; ok {
+			m = p.Mutator()
+		} else {
+			var err error
+			m, err = mutator.NewConfigured(name, opts.Config.Mutators[name])
+			if err != nil {
+				return fmt.Errorf("Could not configure mutator %q: %v", name, err)
+			}
+		}
+		s.mutators = append(s.mutators, mutatorItem{
+			Name:    name,
+			Mutator: m,
+		})
+	}
+
+	return nil
+}
+
+// closePlugins stops the loaded plugins.
+func (s *run) closePlugins() {
+	for name, p := range s.plugins {
+		err := p.Close()
+		if err != nil {
+			s.console.Message("Plugin %q exited with an error: %v", name, err)
+		}
+	}
+}
+
+// removeTmpDir removes the directory of the saved mutations unless it should be kept.
+func (s *run) removeTmpDir() {
+	if s.opts.General.DoNotRemoveTmpFolder {
+		return
+	}
+
+	err := os.RemoveAll(s.tmpDir)
+	if err != nil {
+		s.console.Message("Could not remove %q: %v", s.tmpDir, err)
+
+		return
+	}
+	s.console.Debug("Remove %q", s.tmpDir)
+}
+
+// mutateFile mutates the given file and executes its mutations with the workers unless the file is excluded.
+func (s *run) mutateFile(ctx context.Context, file string) error {
+	opts := s.opts
+
+	if s.maxFileSize > 0 {
+		stat, err := os.Stat(file)
+		if err != nil {
+			return fmt.Errorf("Could not get the size of file %q: %v", file, err)
+		}
+
+		if stat.Size() > s.maxFileSize {
+			s.console.Verbose("Skip %q since its size of %d bytes is over the limit", file, stat.Size())
+
+			s.report.ExcludedFiles = append(s.report.ExcludedFiles, models.ExcludedFile{
+				File:   file,
+				Reason: models.ExcludedBySize,
+				Size:   stat.Size(),
+			})
+			s.progress.excludeFile()
+
+			return nil
+		}
+	}
+
+	s.console.Verbose("Mutate %q", file)
+
+	annotationProcessor := annotation.NewProcessor()
+	skipFilterProcessor := filter.NewSkipMakeArgsFilter()
+
+	collectors := []filter.NodeCollector{
+		annotationProcessor,
+		skipFilterProcessor,
+	}
+
+	filters := []filter.NodeFilter{
+		annotationProcessor,
+		skipFilterProcessor,
+	}
+
+	if s.changes != nil {
+		changedLinesFilter := filter.NewChangedLinesFilter(s.changes)
+		if opts.Filter.ChangedFunctions {
+			changedLinesFilter = filter.NewChangedFunctionsFilter(s.changes)
+		}
+
+		collectors = append(collectors, changedLinesFilter)
+		filters = append(filters, changedLinesFilter)
+	}
+
+	loaded := s.profiler.track(phaseLoading)
+	src, fset, pkg, info, err := parser.ParseAndTypeCheckFile(file, opts.Files.Tags, collectors)
+	loaded()
+	if err != nil {
+		return err
+	}
+	s.filePackages[file] = pkg.Path()
+
+	if opts.Filter.SkipFilesOverMutants > 0 {
+		count := countMutations(s.mutators, pkg, info, src, filters)
+		if count > opts.Filter.SkipFilesOverMutants {
+			s.console.Verbose("Skip %q since its %d mutations are over the limit", file, count)
+
+			s.report.ExcludedFiles = append(s.report.ExcludedFiles, models.ExcludedFile{
+				File:    file,
+				Reason:  models.ExcludedByMutants,
+				Mutants: count,
+			})
+			s.progress.excludeFile()
+
+			return nil
+		}
+	}
+
+	untested := s.untested(file, pkg)
+
+	tmpFile := s.tmpDir + "/" + file
+
+	// A dry run does not write any files
+	if s.dryRun == nil {
+		err = os.MkdirAll(s.tmpDir+"/"+filepath.Dir(file), 0755)
+		if err != nil {
+			return fmt.Errorf("Could not create directory of the mutations of %q: %v", file, err)
+		}
+
+		originalFile := fmt.Sprintf("%s.original", tmpFile)
+		err = osutil.CopyFile(file, originalFile)
+		if err != nil {
+			return fmt.Errorf("Could not save original of %q: %v", file, err)
+		}
+		s.console.Debug("Save original into %q", originalFile)
+	}
+
+	nodes := []ast.Node{src}
+	if s.match != nil {
+		nodes = nil
+		for _, f := range astutil.Functions(src) {
+			if s.match.MatchString(f.Name.Name) {
+				nodes = append(nodes, f)
+			}
+		}
+	}
+
+	if s.progress != nil {
+		count := 0
+		for _, node := range nodes {
+			count += countMutations(s.mutators, pkg, info, node, filters)
+		}
+		s.progress.startFile(file, count)
+	}
+
+	mutationID := 0
+	for _, node := range nodes {
+		mutationID, err = s.mutate(ctx, mutationID, pkg, info, file, fset, src, node, tmpFile, untested, filters)
+		if err != nil {
+			return err
+		}
+	}
+
+	for name, p := range s.plugins {
+		if err := p.Err(); err != nil {
+			return fmt.Errorf("Plugin %q failed for %q: %v", name, file, err)
+		}
+	}
+
+	return nil
+}
+
+// untested checks if the package of the given file has no test files.
+// The built-in exec command tests only the package of the mutated file, so packages without test files can not kill any mutation.
+func (s *run) untested(file string, pkg *types.Package) bool {
+	opts := s.opts
+
+	if len(s.execs) > 0 || opts.Exec.BuildSystem != "" || opts.Test.Recursive || opts.Exec.NoExec {
+		return false
+	}
+
+	dir := filepath.Dir(file)
+
+	found, ok := s.testFiles[dir]
+	if !ok {
+		found = hasTestFiles(dir, opts.Files.Tags)
+		s.testFiles[dir] = found
+
+		if !found {
+			s.console.Verbose("Package %q has no test files, its mutations are not executed", pkg.Path())
+
+			s.report.UntestedPackages = append(s.report.UntestedPackages, pkg.Path())
+		}
+	}
+
+	return !found
+}
+
+// finish completes the report after all mutations were executed, there is no report if only patches were written or mutations were printed.
+func (s *run) finish(stopped string) (*models.Report, error) {
+	opts := s.opts
+
+	if s.patches != nil {
+		err := s.patches.close()
+		if err != nil {
+			return nil, fmt.Errorf("Could not write patch index: %v", err)
+		}
+
+		s.console.Message("Saved %d patches into %q", len(s.patches.entries), opts.Mutate.Out)
+
+		return nil, nil
+	}
+
+	if s.dryRun != nil {
+		s.console.Message("%d mutations would be executed", s.dryRun.count)
+
+		return nil, nil
+	}
+
+	report := s.report
+	if s.baseline != nil {
+		report = s.baseline.Merge(report)
+	}
+
+	if s.newCode != nil {
+		report.StrictNewCode = opts.Report.StrictNewCode
+		markNewCode(report.Escaped, s.newCode)
+	}
+
+	report.RunName = opts.Report.RunName
+	report.MinMsi = opts.Report.MinMsi
+	report.Labels = s.labels
+	report.Stopped = stopped
+	report.GoBinary = s.pinnedGo
+	report.GoVersion = s.pinnedGoVersion
+	report.ExcludeNotCovered = opts.Config.ExcludeNotCovered
+	report.AggregatePackages(s.filePackages)
+	report.Calculate()
+	if s.coverage.profile != nil || s.coverage.selector != nil {
+		report.CalculateCoverage()
+	}
+
+	return report, nil
+}
+
+type mutatorItem struct {
+	Name    string
+	Mutator mutator.Mutator
+}
+
+// mutate applies every mutator to the given node of the file and returns the next mutation ID.
+// The walks of the mutators are finished after an error, but their mutations are not handled anymore.
+func (s *run) mutate(
+	ctx context.Context,
+	mutationID int,
+	pkg *types.Package,
+	info *types.Info,
+	originalFile string,
+	fset *token.FileSet,
+	src ast.Node,
+	node ast.Node,
+	mutatedFile string,
+	untested bool,
+	filters []filter.NodeFilter,
+) (int, error) {
+	var err error
+
+	for _, m := range s.mutators {
+		s.console.Debug("Mutator %s", m.Name)
+
+		mutatorAnnotated := annotation.DecoratorFilter(m.Mutator, m.Name, filters...)
+
+		walk := newMutateWalk(pkg, info, node, mutatorAnnotated)
+		changed := walk.changed
+
+		for {
+			generated := s.profiler.track(phaseGeneration)
+			_, ok := <-changed
+			generated()
+
+			if !ok {
+				break
+			}
+
+			if err == nil {
+				mutationFile := fmt.Sprintf("%s.%d", mutatedFile, mutationID)
+				err = s.mutation(ctx, m.Name, pkg, originalFile, fset, src, fset.Position(walk.pos), mutationFile, untested)
+			}
+
+			changed <- true
+
+			// Ignore original state
+			<-changed
+			changed <- true
+
+			mutationID++
+		}
+	}
+
+	return mutationID, err
+}
+
+// mutation writes the current mutation of the source code of the file and hands it to the workers, or ignores it.
+func (s *run) mutation(
+	ctx context.Context,
+	mutatorName string,
+	pkg *types.Package,
+	originalFile string,
+	fset *token.FileSet,
+	src ast.Node,
+	position token.Position,
+	mutationFile string,
+	untested bool,
+) error {
+	opts := s.opts
+
+	originalSourceCode, err := os.ReadFile(originalFile)
+	if err != nil {
+		return fmt.Errorf("Could not read file %q: %v", originalFile, err)
+	}
+
+	mutant := models.Mutant{}
+	mutant.Mutator.MutatorName = mutatorName
+	mutant.Mutator.OriginalFilePath = originalFile
+	mutant.Mutator.OriginalSourceCode = string(originalSourceCode)
+	mutant.Mutator.OriginalStartLine = int64(position.Line)
+	mutant.Mutator.OriginalStartColumn = int64(position.Column)
+	mutant.Mutator.ID = s.checksums.id(originalFile, mutatorName, mutant.Mutator.OriginalStartLine, mutant.Mutator.OriginalStartColumn)
+	if file, ok := src.(*ast.File); ok {
+		mutant.Mutator.Function = functionName(fset, file, position.Line)
+	}
+
+	printed := s.profiler.track(phasePrinting)
+	printedSourceCode, mutatedSourceCode, err := printAST(fset, src)
+	printed()
+
+	var checksum string
+	var duplicate bool
+	var duplicateOf models.Duplicate
+	if err == nil {
+		hashed := s.profiler.track(phaseHashing)
+		checksum = s.checksums.checksum(mutatorName, pkg.Path()+"/"+filepath.Base(originalFile), mutant.Mutator.OriginalStartLine, printedSourceCode)
+
+		ref := &models.MutantReference{
+			ID:                  mutant.Mutator.ID,
+			Checksum:            checksum,
+			MutatorName:         mutatorName,
+			OriginalFilePath:    originalFile,
+			OriginalStartLine:   mutant.Mutator.OriginalStartLine,
+			OriginalStartColumn: mutant.Mutator.OriginalStartColumn,
+		}
+
+		var original *models.MutantReference
+		original, duplicate = s.checksums.duplicate(ref, printedSourceCode)
+		hashed()
+		if duplicate {
+			duplicateOf = models.Duplicate{
+				MutantReference: *ref,
+				Original:        original,
+			}
+		} else if s.dryRun == nil {
+			// Duplicates are not saved since they are not executed
+			written := s.profiler.track(phaseWriting)
+			err = os.WriteFile(mutationFile, mutatedSourceCode, 0666)
+			written()
+		}
+
+		s.stream.generated(mutant, checksum)
+	}
+
+	if err != nil {
+		s.console.Message("INTERNAL ERROR %s", err.Error())
+
+		s.workers.ignore()
+
+		return nil
+	} else if ctx.Err() != nil {
+		s.console.Debug("%q is not executed since the run is canceled", mutationFile)
+
+		s.workers.ignore()
+
+		return nil
+	}
+
+	if s.baseline != nil {
+		escaped := false
+		if !duplicate {
+			escaped, err = escapedInBaseline(s.baseline, originalFile, mutationFile)
+			if err != nil {
+				return err
+			}
+		}
+
+		if !escaped {
+			s.console.Debug("%q did not escape in the baseline report, we ignore it", mutationFile)
+
+			s.workers.ignore()
+
+			return nil
+		}
+	}
+
+	if duplicate {
+		s.console.Debug("%q is a duplicate, we ignore it", mutationFile)
+
+		s.workers.duplicate(duplicateOf, pkg)
+
+		return nil
+	} else if !sampleMutation(checksum, opts.Filter.SampleRate, opts.Filter.Seed) {
+		s.console.Debug("%q is not part of the sample, we ignore it", mutationFile)
+
+		s.workers.ignore()
+
+		return nil
+	} else if s.dryRun != nil {
+		s.dryRun.print(mutant, originalSourceCode, mutatedSourceCode)
+
+		s.workers.ignore()
+
+		return nil
+	} else if untested && s.patches == nil {
+		s.console.Debug("%q has no tests, we do not execute it", mutationFile)
+
+		written := s.profiler.track(phaseWriting)
+		diff, err := diffMutation(originalFile, mutationFile, "Original", "New")
+		written()
+		if err != nil {
+			return fmt.Errorf("Could not diff mutation %q: %v", mutationFile, err)
+		}
+
+		mutant.Diff = string(diff)
+
+		if opts.Config.UntestedEscaped {
+			s.workers.noTests(mutantJob{
+				mutant:       mutant,
+				pkg:          pkg,
+				originalFile: originalFile,
+				mutationFile: mutationFile,
+				checksum:     checksum,
+			})
+		} else {
+			s.workers.notCovered(mutantJob{
+				mutant:       mutant,
+				pkg:          pkg,
+				originalFile: originalFile,
+				checksum:     checksum,
+			})
+		}
+
+		return nil
+	}
+
+	tests, covered, err := s.coverage.tests(pkg, originalFile, originalSourceCode, mutationFile)
+	if err != nil {
+		return err
+	}
+
+	if !covered {
+		s.console.Debug("%q is not covered by tests, we ignore it", mutationFile)
+
+		written := s.profiler.track(phaseWriting)
+		diff, err := diffMutation(originalFile, mutationFile, "Original", "New")
+		written()
+		if err != nil {
+			return fmt.Errorf("Could not diff mutation %q: %v", mutationFile, err)
+		}
+
+		mutant.Diff = string(diff)
+
+		s.workers.notCovered(mutantJob{
+			mutant:       mutant,
+			pkg:          pkg,
+			originalFile: originalFile,
+			checksum:     checksum,
+		})
+
+		return nil
+	}
+
+	s.console.Debug("Save mutation into %q with checksum %s", mutationFile, checksum)
+
+	if tests != nil {
+		s.console.Debug("Select tests %s", strings.Join(tests, ", "))
+	}
+
+	// The verdict of the mutation only holds for the executed tests
+	mutant.TestRestriction = s.coverage.runPattern(tests)
+
+	if s.patches != nil {
+		err = s.patches.write(mutatorName, pkg.Path(), originalFile, mutationFile, checksum, mutant.Mutator.OriginalStartLine)
+		if err != nil {
+			return fmt.Errorf("Could not write patch of mutation %q: %v", mutationFile, err)
+		}
+
+		s.workers.ignore()
+	} else if opts.Exec.NoExec {
+		s.workers.ignore()
+	} else {
+		s.workers.submit(mutantJob{
+			mutant:       mutant,
+			pkg:          pkg,
+			originalFile: originalFile,
+			mutationFile: mutationFile,
+			checksum:     checksum,
+			run:          mutant.TestRestriction,
+			tests:        tests,
+		})
+	}
+
+	return nil
+}
+
+// markNewCode marks the mutants whose start line changed according to the given changes as new code.
+func markNewCode(mutants []models.Mutant, changes *gitdiff.Changes) {
+	for i := range mutants {
+		mutants[i].NewCode = changes.ChangedLine(mutants[i].Mutator.OriginalFilePath, int(mutants[i].Mutator.OriginalStartLine))
+	}
+}
+
+// hasTestFiles checks if the package in the given directory has test files with the given comma separated build tags.
+// If the package can not be imported it is assumed to have tests.
+func hasTestFiles(dir string, tags string) bool {
+	ctx := build.Default
+	if tags != "" {
+		ctx.BuildTags = strings.Split(tags, ",")
+	}
+
+	pkg, err := ctx.ImportDir(dir, 0)
+	if err != nil {
+		return true
+	}
+
+	return len(pkg.TestGoFiles) > 0 || len(pkg.XTestGoFiles) > 0
+}
+
+// matchMutator checks if the name of the mutator matches one of the given names or suffix patterns.
+func matchMutator(name string, patterns []string) bool {
+	for _, d := range patterns {
+		if prefix, pattern := strings.CutSuffix(d, "*"); (pattern && strings.HasPrefix(name, prefix)) || (!pattern && name == d) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// PackFast is the built-in pack of mutators which produce few mutations with a high signal, e.g. for pre-commit hooks.
+// It is overridden by a pack of the same name in the packs config parameter.
+const PackFast = "fast"
+
+// builtinPacks are the packs which are used if they are not defined in the packs config parameter.
+var builtinPacks = map[string][]string{
+	PackFast: {
+		"arithmetic/base",
+		"branch/if",
+		"expression/comparison",
+		"expression/remove",
+	},
+}
+
+// mutatorsOfPacks returns the mutator names and patterns of the given packs which are separated by commas.
+// Every mutator of a pack has to match one of the available mutators.
+func mutatorsOfPacks(packs map[string][]string, names []string, available []string) ([]string, error) {
+	mutators := []string{}
+	for _, names := range names {
+		for _, name := range strings.Split(names, ",") {
+			name = strings.TrimSpace(name)
+
+			pack, ok := packs[name]
+			if !ok {
+				pack, ok = builtinPacks[name]
+			}
+			if !ok {
+				return nil, fmt.Errorf("pack %q is not defined in the packs config parameter", name)
+			}
+
+			for _, m := range pack {
+				found := false
+				for _, registered := range available {
+					if matchMutator(registered, []string{m}) {
+						found = true
+
+						break
+					}
+				}
+				if !found {
+					return nil, fmt.Errorf("pack %q contains the unknown mutator %q", name, m)
+				}
+			}
+
+			mutators = append(mutators, pack...)
+		}
+	}
+
+	return mutators, nil
+}
+
+// countMutations returns the count of mutations of the node by all mutators without applying them.
+func countMutations(mutators []mutatorItem, pkg *types.Package, info *types.Info, node ast.Node, filters []filter.NodeFilter) int {
+	count := 0
+	for _, m := range mutators {
+		count += CountWalk(pkg, info, node, annotation.DecoratorFilter(m.Mutator, m.Name, filters...))
+	}
+
+	return count
+}
+
+// parseSize parses a size in bytes with an optional KB, MB or GB suffix, which are powers of 1024.
+func parseSize(size string) (int64, error) {
+	s := strings.ToUpper(strings.TrimSpace(size))
+
+	unit := int64(1)
+	for _, suffix := range []struct {
+		name string
+		unit int64
+	}{
+		{"KB", 1 << 10},
+		{"MB", 1 << 20},
+		{"GB", 1 << 30},
+		{"B", 1},
+	} {
+		if strings.HasSuffix(s, suffix.name) {
+			s = strings.TrimSpace(strings.TrimSuffix(s, suffix.name))
+			unit = suffix.unit
+
+			break
+		}
+	}
+
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("it is not a positive number with an optional KB, MB or GB suffix")
+	}
+
+	return n * unit, nil
+}
+
+// parseLabels parses labels of the form key=value, later labels override earlier labels with the same key.
+func parseLabels(labels []string) (map[string]string, error) {
+	if len(labels) == 0 {
+		return nil, nil
+	}
+
+	parsed := map[string]string{}
+	for _, label := range labels {
+		key, value, ok := strings.Cut(label, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("Label %q is not of the form key=value", label)
+		}
+
+		parsed[key] = value
+	}
+
+	return parsed, nil
+}
+
+// sampleMutation decides deterministically by the checksum of the mutation and the seed if the mutation is part of the random sample of the given rate.
+func sampleMutation(checksum string, rate float64, seed int64) bool {
+	if rate >= 1 {
+		return true
+	}
+
+	h := fnv.New64a()
+	_, _ = fmt.Fprintf(h, "%d:%s", seed, checksum)
+
+	return float64(h.Sum64()) < rate*math.MaxUint64
+}
+
+// escapedInBaseline checks if the mutation escaped in the baseline report.
+func escapedInBaseline(baseline *models.Baseline, originalFile string, mutationFile string) (bool, error) {
+	mutatedSourceCode, err := os.ReadFile(mutationFile)
+	if err != nil {
+		return false, fmt.Errorf("Could not read mutation %q: %v", mutationFile, err)
+	}
+
+	return baseline.Escaped(originalFile, string(mutatedSourceCode)), nil
+}
+
+// mutationCoverage decides with a coverage profile and the coverage of every test which mutations are covered and which tests should be executed.
+type mutationCoverage struct {
+	profile  *coverage.Profile
+	selector *impact.Selector
+	// run is the "go test -run" pattern of the user which restricts the executed tests.
+	run *regexp.Regexp
+}
+
+// tests checks if the changed lines of the mutation are covered and returns the tests which execute them.
+// Without a coverage profile and test selection everything is covered and all tests are executed which is denoted by nil.
+func (c *mutationCoverage) tests(pkg *types.Package, originalFile string, originalSourceCode []byte, mutationFile string) ([]string, bool, error) {
+	if c.profile == nil && c.selector == nil {
+		return nil, true, nil
+	}
+
+	mutatedSourceCode, err := os.ReadFile(mutationFile)
+	if err != nil {
+		return nil, false, fmt.Errorf("Could not read mutation %q: %v", mutationFile, err)
+	}
+
+	startLine, endLine := parser.ChangedLines(originalSourceCode, mutatedSourceCode)
+
+	if c.profile != nil && !c.profile.Covered(pkg.Path(), originalFile, startLine, endLine) {
+		return nil, false, nil
+	}
+
+	if c.selector == nil {
+		return nil, true, nil
+	}
+
+	tests, err := c.selector.Tests(pkg.Path(), originalFile, startLine, endLine)
+	if err != nil {
+		return nil, false, fmt.Errorf("Could not select the tests of mutation %q: %v", mutationFile, err)
+	}
+
+	if c.run != nil {
+		var matched []string
+		for _, name := range tests {
+			if c.run.MatchString(name) {
+				matched = append(matched, name)
+			}
+		}
+		tests = matched
+	}
+
+	return tests, len(tests) != 0, nil
+}
+
+// runPattern returns the "go test -run" pattern of the given selected tests or of the user, an empty pattern executes all tests.
+func (c *mutationCoverage) runPattern(tests []string) string {
+	if tests != nil {
+		return impact.RunPattern(tests)
+	} else if c.run != nil {
+		return c.run.String()
+	}
+
+	return ""
+}
@@ -0,0 +1,398 @@
+package mutesting
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/VirtualRoyalty/go-mutesting/internal/models"
+	_ "github.com/VirtualRoyalty/go-mutesting/mutator/arithmetic"
+	_ "github.com/VirtualRoyalty/go-mutesting/mutator/branch"
+	_ "github.com/VirtualRoyalty/go-mutesting/mutator/expression"
+	_ "github.com/VirtualRoyalty/go-mutesting/mutator/numbers"
+	_ "github.com/VirtualRoyalty/go-mutesting/mutator/statement"
+)
+
+func TestRunner(t *testing.T) {
+	saveCwd, err := os.Getwd()
+	assert.NoError(t, err)
+	assert.NoError(t, os.Chdir("example"))
+	defer func() {
+		assert.NoError(t, os.Chdir(saveCwd))
+	}()
+
+	opts := NewOptions()
+	opts.Filter.Match = "baz"
+	opts.Remaining.Targets = []string{"./..."}
+
+	var output bytes.Buffer
+	verdicts := map[Verdict]int{}
+	positions := map[string]bool{}
+	ids := map[string]bool{}
+
+	r := NewRunner(opts)
+	r.Output = &output
+	r.OnMutant = func(mutant Mutant, verdict Verdict) {
+		assert.Equal(t, "baz", mutant.Mutator.Function)
+
+		verdicts[verdict]++
+		ids[mutant.Mutator.ID] = true
+		positions[fmt.Sprintf("%s:%d:%d", mutant.Mutator.MutatorName, mutant.Mutator.OriginalStartLine, mutant.Mutator.OriginalStartColumn)] = true
+	}
+
+	report, err := r.Run(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), report.Stats.KilledCount)
+	assert.Equal(t, int64(5), report.Stats.EscapedCount)
+	assert.Equal(t, map[Verdict]int{VerdictKilled: 5, VerdictEscaped: 5}, verdicts)
+	assert.Contains(t, output.String(), "PASS")
+	assert.Equal(t, int64(5), report.Packages["github.com/VirtualRoyalty/go-mutesting/example"].TotalMutantsCount)
+	assert.Equal(t, 1.0, report.Packages["github.com/VirtualRoyalty/go-mutesting/example/sub"].Msi)
+	for _, position := range []string{"numbers/incrementer:51:7", "arithmetic/base:52:6", "statement/remove:52:2"} {
+		assert.True(t, positions[position], position)
+	}
+	assert.Len(t, ids, 10)
+
+	// The IDs of the mutants are the same in every run
+	r.OnMutant = func(mutant Mutant, verdict Verdict) {
+		assert.True(t, ids[mutant.Mutator.ID], mutant.Mutator.ID)
+	}
+
+	_, err = r.Run(context.Background())
+	assert.NoError(t, err)
+}
+
+func TestRunnerStopped(t *testing.T) {
+	saveCwd, err := os.Getwd()
+	assert.NoError(t, err)
+	assert.NoError(t, os.Chdir("example"))
+	defer func() {
+		assert.NoError(t, os.Chdir(saveCwd))
+	}()
+
+	opts := NewOptions()
+	opts.Filter.Match = "baz"
+	opts.Remaining.Targets = []string{"./..."}
+	opts.Exec.FailFast = true
+
+	r := NewRunner(opts)
+	r.Output = &bytes.Buffer{}
+
+	report, err := r.Run(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, models.StoppedByFailFast, report.Stopped)
+	assert.Equal(t, int64(1), report.Stats.EscapedCount)
+
+	opts.Exec.FailFast = false
+	opts.Exec.TimeBudget = time.Nanosecond
+
+	report, err = r.Run(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, models.StoppedByTimeBudget, report.Stopped)
+	assert.Equal(t, int64(0), report.Stats.TotalMutantsCount)
+}
+
+func TestRunnerStream(t *testing.T) {
+	saveCwd, err := os.Getwd()
+	assert.NoError(t, err)
+	assert.NoError(t, os.Chdir("example"))
+	defer func() {
+		assert.NoError(t, os.Chdir(saveCwd))
+	}()
+
+	opts := NewOptions()
+	opts.Filter.Match = "baz"
+	opts.Remaining.Targets = []string{"./..."}
+	opts.Report.Stream = StreamNDJSON
+	opts.Report.StreamFile = filepath.Join(t.TempDir(), "stream.ndjson")
+
+	r := NewRunner(opts)
+	r.Output = &bytes.Buffer{}
+
+	_, err = r.Run(context.Background())
+	assert.NoError(t, err)
+
+	content, err := os.ReadFile(opts.Report.StreamFile)
+	assert.NoError(t, err)
+
+	events := map[string]int{}
+	verdicts := map[Verdict]int{}
+	for _, line := range strings.Split(strings.TrimSpace(string(content)), "\n") {
+		var event streamEvent
+		assert.NoError(t, json.Unmarshal([]byte(line), &event), line)
+		assert.NotEmpty(t, event.Checksum)
+		assert.Equal(t, "baz", event.Function)
+
+		events[event.Event]++
+		if event.Event == streamOutcome {
+			verdicts[event.Verdict]++
+		}
+	}
+	assert.Equal(t, map[string]int{streamGenerated: 10, streamExecuted: 10, streamOutcome: 10}, events)
+	assert.Equal(t, map[Verdict]int{VerdictKilled: 5, VerdictEscaped: 5}, verdicts)
+
+	opts.Report.Stream = ""
+
+	_, err = r.Run(context.Background())
+	assert.Error(t, err)
+}
+
+func TestRunnerStreamTest2JSON(t *testing.T) {
+	saveCwd, err := os.Getwd()
+	assert.NoError(t, err)
+	assert.NoError(t, os.Chdir("example"))
+	defer func() {
+		assert.NoError(t, os.Chdir(saveCwd))
+	}()
+
+	opts := NewOptions()
+	opts.Filter.Match = "baz"
+	opts.Remaining.Targets = []string{"./..."}
+	opts.Report.Stream = StreamTest2JSON
+	opts.Report.StreamFile = filepath.Join(t.TempDir(), "stream.json")
+
+	r := NewRunner(opts)
+	r.Output = &bytes.Buffer{}
+
+	_, err = r.Run(context.Background())
+	assert.NoError(t, err)
+
+	content, err := os.ReadFile(opts.Report.StreamFile)
+	assert.NoError(t, err)
+
+	tests := map[string]int{}
+	packages := map[string]string{}
+	for _, line := range strings.Split(strings.TrimSpace(string(content)), "\n") {
+		var event test2jsonEvent
+		assert.NoError(t, json.Unmarshal([]byte(line), &event), line)
+
+		if event.Action == test2jsonOutput {
+			continue
+		} else if event.Test == "" {
+			packages[event.Package] = event.Action
+		} else {
+			tests[event.Action]++
+		}
+	}
+	assert.Equal(t, map[string]int{test2jsonRun: 10, test2jsonPass: 5, test2jsonFail: 5}, tests)
+	assert.Equal(t, map[string]string{
+		"github.com/VirtualRoyalty/go-mutesting/example":     test2jsonFail,
+		"github.com/VirtualRoyalty/go-mutesting/example/sub": test2jsonPass,
+	}, packages)
+	assert.Contains(t, string(content), `"Output":"    example.go:52:6: arithmetic/base\n"`)
+}
+
+func TestRunnerProfileEngine(t *testing.T) {
+	saveCwd, err := os.Getwd()
+	assert.NoError(t, err)
+	assert.NoError(t, os.Chdir("example"))
+	defer func() {
+		assert.NoError(t, os.Chdir(saveCwd))
+	}()
+
+	dir := t.TempDir()
+	cpuProfile := filepath.Join(dir, "cpu.out")
+	memProfile := filepath.Join(dir, "mem.out")
+
+	opts := NewOptions()
+	opts.Filter.Match = "baz"
+	opts.Remaining.Targets = []string{"./..."}
+	opts.General.ProfileEngine = cpuProfile + "," + memProfile
+
+	r := NewRunner(opts)
+	output := &bytes.Buffer{}
+	r.Output = output
+
+	_, err = r.Run(context.Background())
+	assert.NoError(t, err)
+
+	for _, profile := range []string{cpuProfile, memProfile} {
+		stat, err := os.Stat(profile)
+		if assert.NoError(t, err) {
+			assert.NotZero(t, stat.Size())
+		}
+	}
+	assert.Regexp(t, `The engine took \S+ \(loading \S+, generation \S+, printing \S+, hashing \S+, writing \S+\) and the tests took \S+ of the run of \S+`, output.String())
+
+	opts.General.ProfileEngine = "cpu.out,mem.out,other.out"
+	_, err = NewRunner(opts).Run(context.Background())
+	assert.EqualError(t, err, `Engine profiles "cpu.out,mem.out,other.out" are not of the form cpu-file[,mem-file]`)
+}
+
+func TestRunnerGoBinary(t *testing.T) {
+	goBinary, err := exec.LookPath("go")
+	assert.NoError(t, err)
+
+	saveCwd, err := os.Getwd()
+	assert.NoError(t, err)
+	assert.NoError(t, os.Chdir("example"))
+	defer func() {
+		assert.NoError(t, os.Chdir(saveCwd))
+	}()
+
+	opts := NewOptions()
+	opts.Filter.Match = "baz"
+	opts.Remaining.Targets = []string{"./..."}
+	opts.Exec.GoBinary = goBinary
+
+	r := NewRunner(opts)
+	r.Output = &bytes.Buffer{}
+
+	report, err := r.Run(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, goBinary, report.GoBinary)
+	assert.True(t, strings.HasPrefix(report.GoVersion, "go"), report.GoVersion)
+	assert.Equal(t, int64(5), report.Stats.KilledCount)
+
+	opts.Exec.GoBinary = filepath.Join(t.TempDir(), "go")
+
+	_, err = r.Run(context.Background())
+	assert.Error(t, err)
+}
+
+func TestRunnerCanceled(t *testing.T) {
+	opts := NewOptions()
+	opts.Remaining.Targets = []string{"./example/..."}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	r := NewRunner(opts)
+	r.Output = &bytes.Buffer{}
+
+	report, err := r.Run(ctx)
+	assert.Equal(t, context.Canceled, err)
+	assert.Nil(t, report)
+}
+
+func TestRunnerExecError(t *testing.T) {
+	saveCwd, err := os.Getwd()
+	assert.NoError(t, err)
+	assert.NoError(t, os.Chdir("example"))
+	defer func() {
+		assert.NoError(t, os.Chdir(saveCwd))
+	}()
+
+	opts := NewOptions()
+	opts.Filter.Match = "baz"
+	opts.Remaining.Targets = []string{"./..."}
+	opts.Exec.Exec = filepath.Join(t.TempDir(), "missing")
+
+	// The error of a worker is returned as well
+	for _, workers := range []int{1, 2} {
+		opts.Exec.Workers = workers
+
+		r := NewRunner(opts)
+		r.Output = &bytes.Buffer{}
+
+		report, err := r.Run(context.Background())
+		assert.ErrorContains(t, err, fmt.Sprintf("Could not execute %q", opts.Exec.Exec), workers)
+		assert.Nil(t, report)
+	}
+}
+
+func TestRunnerOptionsUnchanged(t *testing.T) {
+	saveCwd, err := os.Getwd()
+	assert.NoError(t, err)
+	assert.NoError(t, os.Chdir("example"))
+	defer func() {
+		assert.NoError(t, os.Chdir(saveCwd))
+	}()
+
+	opts := NewOptions()
+	opts.Filter.Match = "baz"
+	opts.Remaining.Targets = []string{"./..."}
+	opts.Mutate.Out = t.TempDir()
+
+	var mutateOutput bytes.Buffer
+	mutate := NewRunner(opts)
+	mutate.Mutate = true
+	mutate.Output = &mutateOutput
+
+	report, err := mutate.Run(context.Background())
+	assert.NoError(t, err)
+	assert.Nil(t, report)
+	assert.False(t, opts.Exec.NoExec)
+
+	dryRunOpts := *opts
+	dryRunOpts.Exec.DryRun = true
+
+	var dryRunOutput bytes.Buffer
+	dryRun := NewRunner(&dryRunOpts)
+	dryRun.Output = &dryRunOutput
+
+	report, err = dryRun.Run(context.Background())
+	assert.NoError(t, err)
+	assert.Nil(t, report)
+	assert.False(t, dryRunOpts.Exec.NoExec)
+
+	// Every runner renders into its own output
+	assert.Contains(t, mutateOutput.String(), "Saved 10 patches")
+	assert.NotContains(t, mutateOutput.String(), "would be executed")
+	assert.Contains(t, dryRunOutput.String(), "10 mutations would be executed")
+	assert.NotContains(t, dryRunOutput.String(), "patches")
+}
+
+func TestParseSize(t *testing.T) {
+	for size, expected := range map[string]int64{
+		"123":   123,
+		"123B":  123,
+		"200KB": 200 * 1024,
+		"2mb":   2 * 1024 * 1024,
+		"1 GB":  1024 * 1024 * 1024,
+	} {
+		n, err := parseSize(size)
+		assert.NoError(t, err, size)
+		assert.Equal(t, expected, n, size)
+	}
+
+	for _, size := range []string{"", "KB", "-1KB", "0", "1TB"} {
+		_, err := parseSize(size)
+		assert.Error(t, err, size)
+	}
+}
+
+func TestParseLabels(t *testing.T) {
+	labels, err := parseLabels([]string{"team=payments", "env=ci", "query=a=b", "empty=", "env=nightly"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "payments", "env": "nightly", "query": "a=b", "empty": ""}, labels)
+
+	labels, err = parseLabels(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, labels)
+
+	for _, label := range []string{"team", "=payments"} {
+		_, err := parseLabels([]string{label})
+		assert.Error(t, err, label)
+	}
+}
+
+func TestMatchMutator(t *testing.T) {
+	for _, test := range []struct {
+		name    string
+		pattern string
+		expect  bool
+	}{
+		{"branch/if", "branch/if", true},
+		{"branch/if", "branch/else", false},
+		{"branch/if", "*", true},
+		{"branch/if", "branch/*", true},
+		{"branchless/if", "branch/*", false},
+		{"branch/if", "branch*", true},
+		{"branc/if", "branch*", false},
+		{"expression/remove", "branch/*", false},
+	} {
+		assert.Equal(t, test.expect, matchMutator(test.name, []string{test.pattern}), fmt.Sprintf("%q with %q", test.name, test.pattern))
+	}
+
+	assert.False(t, matchMutator("branch/if", nil))
+}
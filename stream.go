@@ -0,0 +1,178 @@
+package mutesting
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/VirtualRoyalty/go-mutesting/internal/models"
+)
+
+// StreamNDJSON is the format of --stream which writes every event as a JSON object on its own line.
+const StreamNDJSON = "ndjson"
+
+// Events of the stream
+const (
+	// streamGenerated is written for every generated mutation, even if it is not executed afterwards, e.g. since it is not part of the sample
+	streamGenerated = "generated"
+	// streamExecuted is written after the exec command finished for a mutation
+	streamExecuted = "executed"
+	// streamOutcome is written for every mutation with a verdict
+	streamOutcome = "outcome"
+)
+
+// streamEvent is one line of the stream.
+type streamEvent struct {
+	Event    string    `json:"event"`
+	Time     time.Time `json:"time"`
+	Checksum string    `json:"checksum,omitempty"`
+	ID       string    `json:"id,omitempty"`
+	Mutator  string    `json:"mutator"`
+	File     string    `json:"file"`
+	Line     int64     `json:"line,omitempty"`
+	Column   int64     `json:"column,omitempty"`
+	Function string    `json:"function,omitempty"`
+	// Duration is the count of seconds the exec command took
+	Duration float64 `json:"durationSeconds,omitempty"`
+	Verdict  Verdict `json:"verdict,omitempty"`
+}
+
+// streamWriter writes the events of the run while it progresses so wrappers do not have to wait for the report.
+// All methods can be called on a nil writer which does nothing.
+type streamWriter struct {
+	format  string
+	mutex   sync.Mutex
+	encoder *json.Encoder
+	file    *os.File
+
+	// test2json holds the state of the tests of the test2json format
+	test2json *test2jsonState
+}
+
+// newStreamWriter returns the writer of the stream in the given format into the given file or into the output if no file is given.
+func newStreamWriter(format string, path string, output io.Writer) (*streamWriter, error) {
+	if format == "" {
+		if path != "" {
+			return nil, fmt.Errorf("The stream file of --stream-file needs the format of --stream")
+		}
+
+		return nil, nil
+	} else if format != StreamNDJSON && format != StreamTest2JSON {
+		return nil, fmt.Errorf("Unknown stream format %q", format)
+	}
+
+	w := &streamWriter{
+		format: format,
+	}
+	if format == StreamTest2JSON {
+		w.test2json = newTest2jsonState()
+	}
+	if path != "" {
+		file, err := os.Create(path)
+		if err != nil {
+			return nil, fmt.Errorf("Could not create stream file %q: %v", path, err)
+		}
+
+		w.file = file
+		output = file
+	}
+	w.encoder = json.NewEncoder(output)
+
+	return w, nil
+}
+
+// generated writes the event of a generated mutation.
+func (w *streamWriter) generated(mutant models.Mutant, checksum string) {
+	if w == nil || w.format != StreamNDJSON {
+		return
+	}
+
+	w.write(streamGenerated, mutant, checksum, func(*streamEvent) {})
+}
+
+// executed writes the event of a mutation whose exec command finished after the given duration.
+func (w *streamWriter) executed(job mutantJob, duration time.Duration) {
+	if w == nil {
+		return
+	} else if w.format == StreamTest2JSON {
+		w.mutex.Lock()
+		defer w.mutex.Unlock()
+
+		w.test2json.run(w.encode, job.mutant, packagePathOf(job.pkg), duration)
+
+		return
+	}
+
+	w.write(streamExecuted, job.mutant, job.checksum, func(e *streamEvent) {
+		e.Duration = duration.Seconds()
+	})
+}
+
+// outcome writes the event of the verdict of a mutation of the given package.
+func (w *streamWriter) outcome(mutant models.Mutant, checksum string, pkg string, verdict Verdict) {
+	if w == nil {
+		return
+	} else if w.format == StreamTest2JSON {
+		w.mutex.Lock()
+		defer w.mutex.Unlock()
+
+		w.test2json.outcome(w.encode, mutant, pkg, verdict)
+
+		return
+	}
+
+	w.write(streamOutcome, mutant, checksum, func(e *streamEvent) {
+		e.Verdict = verdict
+	})
+}
+
+func (w *streamWriter) write(event string, mutant models.Mutant, checksum string, set func(e *streamEvent)) {
+	e := streamEvent{
+		Event:    event,
+		Time:     time.Now(),
+		Checksum: checksum,
+		ID:       mutant.Mutator.ID,
+		Mutator:  mutant.Mutator.MutatorName,
+		File:     mutant.Mutator.OriginalFilePath,
+		Line:     mutant.Mutator.OriginalStartLine,
+		Column:   mutant.Mutator.OriginalStartColumn,
+		Function: mutant.Mutator.Function,
+	}
+	set(&e)
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	w.encode(e)
+}
+
+// encode writes the given event, the mutex must be held.
+func (w *streamWriter) encode(e interface{}) {
+	err := w.encoder.Encode(e)
+	if err != nil {
+		log.Printf("Error writing stream: %s", err)
+	}
+}
+
+// close writes the final events of the stream and closes the stream file.
+func (w *streamWriter) close() error {
+	if w == nil {
+		return nil
+	}
+
+	if w.format == StreamTest2JSON {
+		w.mutex.Lock()
+		w.test2json.finish(w.encode)
+		w.mutex.Unlock()
+	}
+
+	if w.file == nil {
+		return nil
+	}
+
+	return w.file.Close()
+}
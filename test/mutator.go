@@ -35,7 +35,7 @@ func Mutator(t *testing.T, m mutator.Mutator, testFile string, count int) {
 	assert.Nil(t, err)
 
 	// Parse and type-check the original source code
-	src, fset, pkg, info, err := parser.ParseAndTypeCheckFile(testFile, collectors)
+	src, fset, pkg, info, err := parser.ParseAndTypeCheckFile(testFile, "", collectors)
 	assert.Nil(t, err)
 
 	// Mutate a non relevant node
@@ -0,0 +1,167 @@
+package mutesting
+
+import (
+	"fmt"
+	"go/types"
+	"time"
+
+	"github.com/VirtualRoyalty/go-mutesting/internal/models"
+)
+
+// StreamTest2JSON is the format of --stream which writes the events of "go test -json", so tools like gotestsum can render the run.
+// Every mutation is a test named after its ID which passes if the mutation is killed.
+const StreamTest2JSON = "test2json"
+
+// Actions of test2json events
+const (
+	test2jsonRun    = "run"
+	test2jsonOutput = "output"
+	test2jsonPass   = "pass"
+	test2jsonFail   = "fail"
+	test2jsonSkip   = "skip"
+)
+
+// test2jsonActions maps the verdicts of mutations to the actions of their tests.
+var test2jsonActions = map[Verdict]string{
+	VerdictKilled:      test2jsonPass,
+	VerdictTimeout:     test2jsonPass,
+	VerdictCaughtByVet: test2jsonPass,
+	VerdictEscaped:     test2jsonFail,
+	VerdictError:       test2jsonFail,
+	VerdictSkipped:     test2jsonSkip,
+	VerdictNotCovered:  test2jsonSkip,
+	VerdictDuplicated:  test2jsonSkip,
+}
+
+// test2jsonEvent is one line of the stream just like the events of "go tool test2json".
+type test2jsonEvent struct {
+	Time    time.Time `json:",omitempty"`
+	Action  string
+	Package string  `json:",omitempty"`
+	Test    string  `json:",omitempty"`
+	Elapsed float64 `json:",omitempty"`
+	Output  string  `json:",omitempty"`
+}
+
+// test2jsonState tracks the running tests and the results of the packages of the test2json format.
+type test2jsonState struct {
+	start time.Time
+	// durations are the durations of the executed mutations by their IDs until they get their verdict
+	durations map[string]time.Duration
+	packages  []string
+	failed    map[string]bool
+}
+
+func newTest2jsonState() *test2jsonState {
+	return &test2jsonState{
+		start:     time.Now(),
+		durations: map[string]time.Duration{},
+		failed:    map[string]bool{},
+	}
+}
+
+// run writes the start of the test of an executed mutation.
+func (s *test2jsonState) run(encode func(interface{}), mutant models.Mutant, pkg string, duration time.Duration) {
+	s.durations[mutant.Mutator.ID] = duration
+
+	s.started(encode, mutant, pkg)
+}
+
+// outcome writes the result of the test of a mutation, mutations which were not executed start their test first.
+func (s *test2jsonState) outcome(encode func(interface{}), mutant models.Mutant, pkg string, verdict Verdict) {
+	id := mutant.Mutator.ID
+
+	duration, ok := s.durations[id]
+	if ok {
+		delete(s.durations, id)
+	} else {
+		s.started(encode, mutant, pkg)
+	}
+
+	action, ok := test2jsonActions[verdict]
+	if !ok {
+		action = test2jsonFail
+	}
+	if action == test2jsonFail {
+		s.failed[pkg] = true
+	}
+
+	elapsed := duration.Seconds()
+	s.output(encode, pkg, id, fmt.Sprintf("--- %s: %s (%.2fs)\n", test2jsonStatus(action), id, elapsed))
+	encode(test2jsonEvent{
+		Time:    time.Now(),
+		Action:  action,
+		Package: pkg,
+		Test:    id,
+		Elapsed: elapsed,
+	})
+}
+
+// finish writes the results of all packages.
+func (s *test2jsonState) finish(encode func(interface{})) {
+	elapsed := time.Since(s.start).Seconds()
+
+	for _, pkg := range s.packages {
+		action := test2jsonPass
+		if s.failed[pkg] {
+			action = test2jsonFail
+		}
+
+		s.output(encode, pkg, "", test2jsonStatus(action)+"\n")
+		encode(test2jsonEvent{
+			Time:    time.Now(),
+			Action:  action,
+			Package: pkg,
+			Elapsed: elapsed,
+		})
+	}
+}
+
+func (s *test2jsonState) started(encode func(interface{}), mutant models.Mutant, pkg string) {
+	if _, ok := s.failed[pkg]; !ok {
+		s.failed[pkg] = false
+		s.packages = append(s.packages, pkg)
+	}
+
+	id := mutant.Mutator.ID
+
+	encode(test2jsonEvent{
+		Time:    time.Now(),
+		Action:  test2jsonRun,
+		Package: pkg,
+		Test:    id,
+	})
+	s.output(encode, pkg, id, fmt.Sprintf("=== RUN   %s\n", id))
+	s.output(encode, pkg, id, fmt.Sprintf("    %s:%d:%d: %s\n", mutant.Mutator.OriginalFilePath, mutant.Mutator.OriginalStartLine, mutant.Mutator.OriginalStartColumn, mutant.Mutator.MutatorName))
+}
+
+func (s *test2jsonState) output(encode func(interface{}), pkg string, test string, output string) {
+	encode(test2jsonEvent{
+		Time:    time.Now(),
+		Action:  test2jsonOutput,
+		Package: pkg,
+		Test:    test,
+		Output:  output,
+	})
+}
+
+// test2jsonStatus returns the status of the output of "go test" for the given action, e.g. "PASS".
+func test2jsonStatus(action string) string {
+	switch action {
+	case test2jsonPass:
+		return "PASS"
+	case test2jsonSkip:
+		return "SKIP"
+	}
+
+	return "FAIL"
+}
+
+// packagePathOf returns the import path of the given package or an empty string if it is unknown.
+func packagePathOf(pkg *types.Package) string {
+	if pkg == nil {
+		return ""
+	}
+
+	return pkg.Path()
+}
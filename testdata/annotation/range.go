@@ -0,0 +1,18 @@
+//go:build examplemain
+// +build examplemain
+
+package main
+
+import "fmt"
+
+func rangeFunction(x int) int {
+	// mutator-disable-begin numbers/incrementer, statement/remove
+	y := x + 10
+	fmt.Println(y)
+	// mutator-disable-end
+
+	z := y + 10
+
+	// mutator-disable-begin *
+	return z * 2
+}
@@ -0,0 +1,31 @@
+//go:build examplemain
+// +build examplemain
+
+package main
+
+import "fmt"
+
+const FlagRead uint8 = 1
+
+const FlagWrite uint8 = 2
+
+func main() {
+	var flags uint8
+
+	flags = flags | FlagRead
+	flags = flags | FlagWrite
+	flags |= FlagWrite
+	flags &^= FlagRead
+
+	if flags&FlagWrite != 0 {
+		fmt.Println("write")
+	}
+	if (flags & FlagRead) == 0 {
+		fmt.Println("no read")
+	}
+
+	mask := flags | flags
+	same := flags == mask
+
+	fmt.Println(flags, mask, same)
+}
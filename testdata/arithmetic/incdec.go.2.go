@@ -0,0 +1,20 @@
+//go:build examplemain
+// +build examplemain
+
+package main
+
+import (
+	"fmt"
+)
+
+func main() {
+	count := 0
+	for i := 0; i < 3; i++ {
+		count++
+	}
+
+	remaining := 10
+	remaining++
+
+	fmt.Println(count, remaining)
+}
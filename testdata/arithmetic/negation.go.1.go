@@ -0,0 +1,19 @@
+//go:build examplemain
+// +build examplemain
+
+package main
+
+import (
+	"fmt"
+)
+
+func main() {
+	x := 3
+	y := -x
+	z := x - 1
+
+	var small int8 = -128
+	var big int8 = -127
+
+	fmt.Println(y, z, -x*2, small, big, !true)
+}
@@ -0,0 +1,36 @@
+//go:build examplemain
+// +build examplemain
+
+package main
+
+import (
+	"fmt"
+)
+
+type request struct {
+	Page, PageSize int
+}
+
+func main() {
+	items := make([]int, 100)
+	offset, limit := 10, 20
+
+	fmt.Println(items[offset : offset+limit])
+
+	req := request{Page: 2, PageSize: 25}
+	start := (req.Page - 1) * req.PageSize
+	end := req.Page
+
+	if offset+limit > len(items) {
+		fmt.Println("last page")
+	}
+
+	fmt.Println(start, end, pages(len(items), limit))
+
+	count := 3
+	fmt.Println(offset+1, count*2, items[count+offset])
+}
+
+func pages(total int, size int) int {
+	return total / size
+}
@@ -0,0 +1,27 @@
+//go:build examplemain
+// +build examplemain
+
+package main
+
+import (
+	"fmt"
+)
+
+const flag = 1 << 3
+
+const top int8 = 1 << 6
+
+const high int8 = 1 << 5
+
+var limit int64 = 1<<63 - 1
+
+func main() {
+	var x uint32 = 0xff
+	n := 4
+
+	y := x << n
+	z := x >> 8
+	x <<= 3
+
+	fmt.Println(flag, top, high, limit, x, y, z)
+}
@@ -0,0 +1,41 @@
+//go:build examplemain
+// +build examplemain
+
+package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+var cache map[string]int
+
+func lookup(key string) (int, error) {
+	if cache == nil {
+		cache = map[string]int{}
+	}
+
+	v, ok := cache[key]
+	if !ok {
+		return 0, errors.New("missing")
+	}
+
+	return v, nil
+}
+
+func describe(p *int) string {
+	if true || nil != p {
+		return fmt.Sprint(*p)
+	} else {
+		return "none"
+	}
+}
+
+func main() {
+	v, err := lookup("a")
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Println(v, describe(&v))
+}
@@ -0,0 +1,65 @@
+//go:build examplemain
+// +build examplemain
+
+package main
+
+import (
+	"fmt"
+)
+
+func kind(n int) string {
+	switch {
+	case n < 0:
+		return "negative"
+	case n == 0:
+		return "zero"
+	default:
+		_ = fmt.Println
+	}
+
+	return "positive"
+}
+
+func describe(v interface{}) string {
+	switch v.(type) {
+	case int:
+		return "int"
+	default:
+		return "other"
+	}
+}
+
+func poll(values <-chan int) int {
+	select {
+	case v := <-values:
+		return v
+	default:
+		return -1
+	}
+}
+
+func wait(values <-chan int) {
+	select {
+	case v := <-values:
+		fmt.Println(v)
+	default:
+		fmt.Println("empty")
+	}
+}
+
+func report(n int) {
+	msg := "not positive"
+
+	switch {
+	case n > 0:
+		fmt.Println(n)
+	default:
+		fmt.Println(msg)
+	}
+}
+
+func main() {
+	fmt.Println(kind(1), describe(1), poll(nil))
+	wait(nil)
+	report(1)
+}
@@ -0,0 +1,38 @@
+//go:build examplemain
+// +build examplemain
+
+package main
+
+import (
+	"context"
+	"fmt"
+)
+
+func receive(ctx context.Context, values <-chan int) (int, error) {
+	select {
+	case v := <-values:
+		return v, nil
+	case <-ctx.Done():
+		_ = ctx.Err
+	default:
+	}
+
+	return 0, nil
+}
+
+func wait(done <-chan struct{}) {
+	select {
+	case <-done:
+		fmt.Println("done")
+	}
+}
+
+func main() {
+	values := make(chan int, 1)
+	values <- 1
+	fmt.Println(receive(context.Background(), values))
+
+	done := make(chan struct{})
+	close(done)
+	wait(done)
+}
@@ -0,0 +1,60 @@
+//go:build examplemain
+// +build examplemain
+
+package main
+
+import (
+	"fmt"
+)
+
+func split(s string) []string {
+	return []string{s}
+}
+
+func main() {
+	var names []string
+	for _, s := range []string{"a", "b"} {
+		if s == "a" {
+			names = append(names, split(s)...)
+		} else {
+			names = append(names, s)
+		}
+	}
+	fmt.Println(names)
+
+	max := 0
+	for i := 1; i != 4; i++ {
+		if i > 2 {
+			max = i
+		} else {
+			max = 2
+		}
+
+		var a, b int
+		if i%2 == 0 {
+			a, b = i, 1
+		} else {
+			a, b = i, 1
+		}
+
+		if i == 3 {
+			fmt.Println(a)
+		} else {
+			fmt.Println(b)
+		}
+
+		if i == 1 {
+			a = i
+		} else {
+			b = i
+		}
+
+		if i == 2 {
+			a = 1
+		} else if i == 3 {
+			a = 3
+		}
+	}
+
+	fmt.Println(max)
+}
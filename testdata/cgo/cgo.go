@@ -0,0 +1,19 @@
+//go:build examplemain
+// +build examplemain
+
+package main
+
+// #include <stdlib.h>
+import "C"
+
+import "fmt"
+
+func random(n int) int {
+	return int(C.rand()) % n
+}
+
+func main() {
+	if random(6) > 3 {
+		fmt.Println("high")
+	}
+}
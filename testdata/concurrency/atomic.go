@@ -0,0 +1,42 @@
+//go:build examplemain
+// +build examplemain
+
+package main
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+type counter struct {
+	hits int64
+}
+
+func main() {
+	var total int64
+	var flag int32
+	c := &counter{}
+	p := &total
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			atomic.AddInt64(&total, 1)
+			atomic.AddInt64(&c.hits, 2)
+			atomic.AddInt64(p, -1)
+			atomic.StoreInt32(&flag, 1)
+		}()
+	}
+	wg.Wait()
+
+	if atomic.LoadInt32(&flag) == 1 {
+		fmt.Println(atomic.LoadInt64(&total), atomic.LoadInt64(&c.hits))
+	}
+
+	n := atomic.AddInt64(&total, 1)
+	fmt.Println(n, atomic.CompareAndSwapInt32(&flag, 1, 0))
+}
@@ -0,0 +1,49 @@
+//go:build examplemain
+// +build examplemain
+
+package main
+
+import (
+	"fmt"
+)
+
+func produce(values chan<- int, done chan struct{}) {
+	for i := 0; i < 3; i++ {
+		_, _ = values, i
+
+	}
+	close(values)
+
+	<-done
+}
+
+type job struct {
+	id int
+}
+
+func schedule(jobs chan<- job, id int) {
+	jobs <- job{id: id}
+}
+
+func main() {
+	values := make(chan int)
+	done := make(chan struct{})
+
+	go produce(values, done)
+
+	for v := range values {
+		fmt.Println(v)
+	}
+
+	done <- struct{}{}
+
+	jobs := make(chan job, 1)
+	schedule(jobs, 1)
+	fmt.Println(<-jobs)
+
+	select {
+	case v := <-values:
+		fmt.Println(v)
+	default:
+	}
+}
@@ -0,0 +1,34 @@
+//go:build examplemain
+// +build examplemain
+
+package main
+
+import (
+	"fmt"
+	"sync"
+)
+
+func work(id int, wg *sync.WaitGroup, results chan<- int) {
+	defer wg.Done()
+
+	results <- id * 2
+}
+
+func main() {
+	var wg sync.WaitGroup
+	results := make(chan int, 2)
+
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go work(i, &wg, results)
+	}
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	for r := range results {
+		fmt.Println(r)
+	}
+}
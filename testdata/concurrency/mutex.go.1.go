@@ -0,0 +1,39 @@
+//go:build examplemain
+// +build examplemain
+
+package main
+
+import (
+	"fmt"
+	"sync"
+)
+
+type counter struct{ sync.Mutex }
+
+type cache struct{ mu sync.RWMutex }
+
+var n int
+
+var values = map[string]int{}
+
+func (c *counter) inc() {
+	c.Lock()
+	n++
+	_ = c.Unlock
+}
+
+func (c *cache) get(key string) int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return values[key]
+}
+
+func main() {
+	var mu sync.Mutex
+	var l sync.Locker = &mu
+
+	l.Lock()
+	fmt.Println("locked")
+	l.Unlock()
+}
@@ -0,0 +1,35 @@
+//go:build examplemain
+// +build examplemain
+
+package main
+
+import (
+	"fmt"
+	"time"
+)
+
+func main() {
+	ch := make(chan int)
+
+	go func() {
+		ch <- 1
+	}()
+
+	select {
+	case v := <-ch:
+		fmt.Println(v)
+
+	}
+
+	select {
+	case v := <-ch:
+		fmt.Println(v)
+	case t := <-time.After(0):
+		fmt.Println("timeout", t)
+	}
+
+	select {
+	case <-time.After(10 * time.Millisecond):
+		fmt.Println("done")
+	}
+}
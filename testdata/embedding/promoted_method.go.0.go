@@ -0,0 +1,53 @@
+//go:build examplemain
+// +build examplemain
+
+package main
+
+import "fmt"
+
+type Base struct{}
+
+func (Base) Close() error {
+	fmt.Println("base")
+
+	return nil
+}
+
+type Inner struct {
+	Base
+}
+
+type Logger struct{}
+
+func (Logger) Close() error {
+	fmt.Println("logger")
+
+	return nil
+}
+
+func (Logger) Name() string {
+	return "logger"
+}
+
+type Service struct {
+	Inner
+	Logger
+}
+
+type Overridden struct {
+	Logger
+}
+
+func (Overridden) Name() string {
+	return "overridden"
+}
+
+func main() {
+	s := Service{}
+	_ = s.Inner.Close()
+
+	fmt.Println(s.Name())
+
+	o := Overridden{}
+	fmt.Println(o.Name())
+}
@@ -0,0 +1,50 @@
+//go:build examplemain
+// +build examplemain
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+)
+
+var errNegative = errors.New("negative")
+
+func parse(s string) (int, error) {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("could not parse %q: %w", s, err)
+	}
+
+	if n < 0 {
+		return 0, nil
+	}
+
+	return n, nil
+}
+
+func read(name string) ([]byte, error) {
+	if _, err := os.Stat(name); nil != err {
+		return nil, err
+	}
+
+	return os.ReadFile(name)
+}
+
+func remove(name string) error {
+	_, err := os.Stat(name)
+
+	return err
+}
+
+func main() {
+	n, err := parse("1")
+	if err == nil {
+		fmt.Println(n)
+	}
+
+	fmt.Println(read("go.mod"))
+	fmt.Println(remove("go.mod"))
+}
@@ -0,0 +1,39 @@
+//go:build examplemain
+// +build examplemain
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	pkgerrors "github.com/pkg/errors"
+)
+
+var errNotFound = errors.New("not found")
+
+func open(name string) error {
+	_, err := os.Open(name)
+	if err != nil {
+		return fmt.Errorf("open %s: %v", name, err)
+	}
+
+	return nil
+}
+
+func find() error {
+	return fmt.Errorf("%w", errNotFound)
+}
+
+func load() error {
+	return pkgerrors.Wrap(errNotFound, "load")
+}
+
+func save(name string) error {
+	return pkgerrors.Wrapf(errNotFound, "save %s", name)
+}
+
+func main() {
+	fmt.Println(open("a"), find(), load(), save("b"), fmt.Errorf("%d%%w", 1))
+}
@@ -0,0 +1,26 @@
+//go:build examplemain
+// +build examplemain
+
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+func between(low, high, value int) bool {
+	return low <= value && value <= high
+}
+
+func main() {
+	dst := make([]byte, 3)
+	buf := make([]byte, 0, 64*1024)
+	src := []byte("abc")
+	copy(src, dst)
+
+	low, high := 1, 5
+	fmt.Println(between(low, high, 3), strings.Repeat("a", 3), min(low, low))
+
+	parts := []string{"a", "b"}
+	fmt.Println(strings.Join(parts, ","), float64(low), append(dst, src...), cap(buf))
+}
@@ -0,0 +1,27 @@
+//go:build examplemain
+// +build examplemain
+
+package main
+
+import "fmt"
+
+type options struct {
+	verbose bool
+}
+
+func main() {
+	o := options{verbose: false}
+	enabled := true
+
+	if enabled && !o.verbose {
+		fmt.Println("enabled")
+	}
+
+	fmt.Println(shadowed())
+}
+
+func shadowed() bool {
+	true := false
+
+	return true
+}
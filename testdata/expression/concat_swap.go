@@ -0,0 +1,22 @@
+//go:build examplemain
+// +build examplemain
+
+package main
+
+import (
+	"fmt"
+)
+
+type name string
+
+func main() {
+	dir, file := "/srv", "index.html"
+	path := dir + "/" + file
+
+	var first name = "go"
+	full := first + " mutesting"
+
+	twice := file + file
+
+	fmt.Println(path, full, twice, 1+2)
+}
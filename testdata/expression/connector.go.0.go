@@ -0,0 +1,20 @@
+//go:build examplemain
+// +build examplemain
+
+package main
+
+import (
+	"fmt"
+)
+
+func main() {
+	a, b, c := true, false, true
+
+	if a || b {
+		fmt.Println("both")
+	}
+
+	if a || b && c {
+		fmt.Println("any")
+	}
+}
@@ -0,0 +1,26 @@
+//go:build examplemain
+// +build examplemain
+
+package main
+
+import (
+	"fmt"
+)
+
+func main() {
+	a := []int{1, 2, 3}
+	i := 1
+
+	fmt.Println(a[i+1])
+
+	s := "abc"
+	fmt.Println(s[i+1])
+
+	arr := [3]int{1, 2, 3}
+	fmt.Println(arr[0], arr[2])
+
+	m := map[int]int{1: 1}
+	fmt.Println(m[i])
+
+	a[i] = 4
+}
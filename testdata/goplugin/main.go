@@ -0,0 +1,38 @@
+// Package main is a Go plugin with a mutator which removes the value of every return statement with a single value.
+package main
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+)
+
+// Mutators returns the mutators of the plugin.
+func Mutators() map[string]mutator.Mutator {
+	return map[string]mutator.Mutator{
+		"goplugin/return": mutatorReturn,
+	}
+}
+
+func mutatorReturn(_ *types.Package, _ *types.Info, node ast.Node) []mutator.Mutation {
+	n, ok := node.(*ast.ReturnStmt)
+	if !ok || len(n.Results) != 1 {
+		return nil
+	}
+
+	original := n.Results[0]
+
+	return []mutator.Mutation{
+		{
+			Change: func() {
+				n.Results[0] = ast.NewIdent("0")
+			},
+			Reset: func() {
+				n.Results[0] = original
+			},
+		},
+	}
+}
+
+func main() {}
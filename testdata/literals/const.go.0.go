@@ -0,0 +1,39 @@
+//go:build examplemain
+// +build examplemain
+
+package main
+
+import (
+	"fmt"
+	"runtime"
+	"time"
+)
+
+const retries = 3
+
+const timeout = 5*time.Second + 1
+
+const limit = retries * 2
+
+const ratio = 1.5 / 2
+
+const linux = runtime.GOOS == "linux"
+
+const debug = false
+
+const first = iota + 1
+
+const maxInt8 int8 = 126 + 1
+
+const maxUint8 uint8 = 254 + 0
+
+const mask = 0x7f + 0
+
+const length = 8 << 1
+
+func main() {
+	var b int8 = mask
+	var l uint8 = length
+
+	fmt.Println(retries, timeout, limit, ratio, linux, debug, first, maxInt8, maxUint8, b, l)
+}
@@ -0,0 +1,41 @@
+//go:build examplemain
+// +build examplemain
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type config struct {
+	Name string `json:"name"`
+}
+
+const prefix = "go"
+
+const module = prefix + "-mutesting"
+
+func kind(name string) string {
+	switch name {
+	case "":
+		return "empty"
+	case module, prefix + "lang":
+		return "known"
+	}
+
+	codes := map[string]int{"a": 1, "": 2}
+
+	return name + fmt.Sprint(codes[name])
+}
+
+func main() {
+	c := config{Name: "go-mutesting"}
+
+	content, err := json.Marshal(c)
+	if err != nil {
+		fmt.Printf("could not marshal %q: %v\n", c.Name, err)
+	}
+
+	fmt.Println(string(content), `raw`, kind(c.Name))
+}
@@ -0,0 +1,52 @@
+//go:build examplemain
+// +build examplemain
+
+package main
+
+import "fmt"
+
+func main() {
+	k := 0
+
+	for i := 0; i < 100; i++ {
+		if i%2 == 1 {
+			k += i
+			continue
+		}
+	}
+
+	for j := 0; j < 400; j++ {
+		if j%2 == 1 {
+			k += j
+			break
+		}
+	}
+
+	switch k {
+	case 0:
+		break
+	}
+
+	for _, v := range []int{1, 2, 3} {
+		switch v {
+		case 1:
+			break
+		case 2:
+			continue
+		}
+	}
+
+outer:
+	for i := 0; i < 10; i++ {
+		for j := 0; j < 10; j++ {
+			if j == i {
+				break outer
+			}
+			if j > i {
+				break outer
+			}
+		}
+	}
+
+	fmt.Println(k)
+}
@@ -0,0 +1,24 @@
+//go:build examplemain
+// +build examplemain
+
+package main
+
+import "fmt"
+
+func main() {
+	sum := 0
+
+	for i := 0; i < 10; i++ {
+		sum += i
+	}
+
+	for j := 10; j > 0; j /= 2 {
+		sum += j
+	}
+
+	for sum < 100 {
+		sum *= 2
+	}
+
+	fmt.Println(sum)
+}
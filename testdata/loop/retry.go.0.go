@@ -0,0 +1,34 @@
+//go:build examplemain
+// +build examplemain
+
+package main
+
+import (
+	"fmt"
+)
+
+const maxRetries = 5
+
+func main() {
+	for attempt := 0; attempt < 1; attempt++ {
+		fmt.Println(attempt)
+	}
+
+	for i := 0; i < maxRetries; i++ {
+		fmt.Println(i)
+	}
+
+	retries := 3
+	for retries > 0 {
+		retries--
+	}
+
+	for i := 0; i < 10; i++ {
+		fmt.Println(i)
+	}
+
+	entry := 0
+	for entry < 10 {
+		entry++
+	}
+}
@@ -0,0 +1,49 @@
+//go:build examplemain
+// +build examplemain
+
+package main
+
+import (
+	"fmt"
+)
+
+func expire(cache map[string]int, keys []string) {
+	for _, key := range keys {
+		delete(cache, key)
+	}
+}
+
+func dedup(values []string) []string {
+	seen := map[string]bool{}
+	for _, v := range values {
+		seen[v] = true
+	}
+
+	var l []string
+	for _, v := range values {
+		if seen[v] {
+			l = append(l, v)
+			delete(seen, v)
+		}
+	}
+
+	return l
+}
+
+type timer struct {
+	durations map[string]int
+}
+
+func (t *timer) stop(id string) {
+	delete(t.durations, id)
+}
+
+func main() {
+	cache := map[string]int{"a": 1}
+	expire(cache, []string{"a"})
+	fmt.Println(cache, dedup([]string{"a", "a"}))
+
+	t := &timer{durations: map[string]int{"a": 1}}
+	t.stop("a")
+	fmt.Println(t.durations)
+}
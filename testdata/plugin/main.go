@@ -0,0 +1,47 @@
+// Command plugin is a mutator plugin for the tests which replaces the literal 1 with 3 and "i++" with "i--".
+// It fails for the literal 42 and returns an invalid mutation for "j++".
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+)
+
+type request struct {
+	Kind   string `json:"kind"`
+	Source string `json:"source"`
+}
+
+type response struct {
+	Mutations []string `json:"mutations"`
+	Error     string   `json:"error,omitempty"`
+}
+
+func main() {
+	scanner := bufio.NewScanner(os.Stdin)
+	encoder := json.NewEncoder(os.Stdout)
+
+	for scanner.Scan() {
+		var req request
+		if err := json.Unmarshal(scanner.Bytes(), &req); err != nil {
+			_ = encoder.Encode(response{Error: err.Error()})
+
+			continue
+		}
+
+		resp := response{Mutations: []string{}}
+		switch {
+		case req.Kind == "BasicLit" && req.Source == "1":
+			resp.Mutations = append(resp.Mutations, "3")
+		case req.Kind == "BasicLit" && req.Source == "42":
+			resp.Error = "the answer can not be mutated"
+		case req.Kind == "IncDecStmt" && req.Source == "i++":
+			resp.Mutations = append(resp.Mutations, "i--")
+		case req.Kind == "IncDecStmt" && req.Source == "j++":
+			resp.Mutations = append(resp.Mutations, "j = 0")
+		}
+
+		_ = encoder.Encode(resp)
+	}
+}
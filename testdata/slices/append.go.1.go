@@ -0,0 +1,29 @@
+//go:build examplemain
+// +build examplemain
+
+package main
+
+import (
+	"fmt"
+)
+
+func names(first string, others []string) []string {
+	l := []string{"admin"}
+	l = append(l, first)
+
+	return append(l, others...)
+}
+
+func copyAll(names []string) []string {
+	var l []string
+	for _, name := range names {
+		l = append(l, name)
+	}
+
+	return l
+}
+
+func main() {
+	fmt.Println(names("a", []string{"b"}))
+	fmt.Println(copyAll([]string{"c"}))
+}
@@ -0,0 +1,28 @@
+//go:build examplemain
+// +build examplemain
+
+package main
+
+import (
+	"fmt"
+)
+
+func window(s []int, from, to int) []int {
+	return s[from:to]
+}
+
+func tail(s string) string {
+	return s[2:]
+}
+
+func head(s []int) []int {
+	return s[:0]
+}
+
+func empty(s []int) []int {
+	return s[2:2]
+}
+
+func main() {
+	fmt.Println(window([]int{1, 2, 3}, 0, 2), tail("abc"), head(nil), empty(nil), fmt.Sprint(1)[0:])
+}
@@ -0,0 +1,54 @@
+//go:build examplemain
+// +build examplemain
+
+package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+func mustPositive(n int) int {
+	if n < 0 {
+		panic(fmt.Sprintf("negative %d", n))
+	}
+
+	return n
+}
+
+func check(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
+
+func fail(msg string) {
+	panic(msg)
+}
+
+func unreachable(n int) int {
+	switch n {
+	case 0:
+		return 1
+	default:
+		panic("unreachable")
+	}
+}
+
+func last(n int) (result int) {
+	defer func() {
+		panic(recover())
+	}()
+
+	panic(n)
+}
+
+func end() int {
+	panic("end")
+}
+
+func main() {
+	check(errors.New("boom"))
+	fail("boom")
+	fmt.Println(mustPositive(1), unreachable(0), last(1), end())
+}
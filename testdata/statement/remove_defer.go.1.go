@@ -0,0 +1,60 @@
+//go:build examplemain
+// +build examplemain
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+var mu sync.Mutex
+
+func get(items map[string]string, key string) string {
+	mu.Lock()
+	defer mu.Unlock()
+
+	return items[key]
+}
+
+func read(name string) ([]byte, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	_ = f
+
+	b := make([]byte, 10)
+	_, err = f.Read(b)
+
+	return b, err
+}
+
+func wait() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	<-ctx.Done()
+}
+
+func safe(f func()) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("recovered: %v", r)
+		}
+	}()
+
+	f()
+
+	return nil
+}
+
+func main() {
+	fmt.Println(get(map[string]string{}, "a"))
+	fmt.Println(read("go.mod"))
+	wait()
+	fmt.Println(safe(func() { panic("a") }))
+}
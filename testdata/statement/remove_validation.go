@@ -0,0 +1,71 @@
+//go:build examplemain
+// +build examplemain
+
+package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+type user struct {
+	name string
+}
+
+func (u user) Validate() error {
+	if u.name == "" {
+		return errors.New("empty name")
+	}
+
+	return nil
+}
+
+func checkAge(age int) error {
+	if age < 0 {
+		return errors.New("negative age")
+	}
+
+	return nil
+}
+
+func verified(s string) bool {
+	return s != ""
+}
+
+func register(u user, age int) error {
+	if err := u.Validate(); err != nil {
+		return fmt.Errorf("invalid user: %w", err)
+	}
+
+	err := checkAge(age)
+	if err != nil {
+		return err
+	}
+
+	err = checkAge(age + 1)
+	if err != nil {
+		return err
+	}
+
+	if checkAge(age) != nil {
+		return errors.New("invalid age")
+	}
+
+	if err := u.Validate(); err != nil {
+		return err
+	} else {
+		fmt.Println("valid")
+	}
+
+	if !verified(u.name) {
+		return errors.New("not verified")
+	}
+
+	fmt.Println("registered", u.name, age)
+
+	return nil
+}
+
+func main() {
+	_ = register(user{name: "foo"}, 1)
+}
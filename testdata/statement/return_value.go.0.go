@@ -0,0 +1,78 @@
+//go:build examplemain
+// +build examplemain
+
+package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+type user struct {
+	name string
+}
+
+func count(items []string) int {
+	if len(items) == 0 {
+		return 1
+	}
+
+	return len(items)
+}
+
+func ratio(a, b float64) float64 {
+	return a / b
+}
+
+func valid(n int) bool {
+	return n > 0 && n < 10
+}
+
+func enabled() bool {
+	return true
+}
+
+func name(u *user) string {
+	if u == nil {
+		return ""
+	}
+
+	return u.name
+}
+
+func find(users []user, n string) (*user, error) {
+	for i := range users {
+		if users[i].name == n {
+			return &users[i], nil
+		}
+	}
+
+	return nil, errors.New("not found")
+}
+
+func names(users []user) []string {
+	var l []string
+	for _, u := range users {
+		l = append(l, u.name)
+	}
+
+	return l
+}
+
+func both() (int, error) {
+	return split()
+}
+
+func split() (int, error) {
+	return 1, nil
+}
+
+func first[T any](l []T) T {
+	return l[0]
+}
+
+func main() {
+	u, err := find([]user{{name: "a"}}, "a")
+	fmt.Println(count(nil), ratio(1, 2), valid(1), enabled(), name(u), err, names(nil), first([]int{1}))
+	fmt.Println(both())
+}
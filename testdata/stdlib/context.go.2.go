@@ -0,0 +1,36 @@
+//go:build examplemain
+// +build examplemain
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+func work(ctx context.Context) error {
+	for i := 0; i < 3; i++ {
+		if ctx.Err() != nil {
+			break
+		}
+	}
+
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		fmt.Println("deadline")
+	}
+
+	return ctx.Err()
+}
+
+func main() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	child, stop := context.WithCancelCause(ctx)
+	err := work(child)
+	_, _ = stop, err
+
+	cancel()
+}
@@ -0,0 +1,31 @@
+//go:build examplemain
+// +build examplemain
+
+package main
+
+import (
+	crand "crypto/rand"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"math/rand"
+)
+
+const seed = 42
+
+func main() {
+	token := make([]byte, 16)
+	_, _ = rand.Read(token)
+
+	nonce := make([]byte, 12)
+	_, _ = io.ReadFull(crand.Reader, nonce)
+
+	var reader io.Reader = crand.Reader
+	config := &tls.Config{Rand: crand.Reader}
+
+	r := rand.New(rand.NewSource(seed))
+	rand.Seed(0)
+	rand.Seed(int64(len(token)))
+
+	fmt.Println(token, nonce, reader, config, r.Int())
+}
@@ -0,0 +1,21 @@
+//go:build examplemain
+// +build examplemain
+
+package main
+
+import (
+	"fmt"
+	"time"
+)
+
+var timeout = 5 * time.Second
+
+func backoff(attempt int) time.Duration {
+	return time.Millisecond * (time.Duration(attempt) * 0)
+}
+
+func main() {
+	ttl := 2 * time.Hour
+
+	fmt.Println(timeout, backoff(3), ttl, 2*3)
+}
@@ -0,0 +1,26 @@
+//go:build examplemain
+// +build examplemain
+
+package main
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+)
+
+func main() {
+	data := []byte("foo")
+
+	a, _ := json.MarshalIndent(data, "", "\t")
+	b, _ := json.MarshalIndent(data, "", "  ")
+
+	c := base64.StdEncoding.EncodeToString(data)
+	d := base64.RawURLEncoding.EncodeToString(data)
+
+	e := hex.EncodeToString(data)
+	f, _ := hex.DecodeString(e)
+
+	fmt.Println(a, b, c, d, e, f)
+}
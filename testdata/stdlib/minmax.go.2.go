@@ -0,0 +1,27 @@
+//go:build examplemain
+// +build examplemain
+
+package main
+
+import (
+	"fmt"
+	"math"
+)
+
+func clamp(v, low, high int) int {
+	return max(low, min(v, high))
+}
+
+func limit(v float64) float64 {
+	return math.Max(v, 100)
+}
+
+func shadowed(v int) int {
+	max := 10
+
+	return min(v, max)
+}
+
+func main() {
+	fmt.Println(clamp(5, 0, 10), limit(200), math.Max(1, 2), shadowed(3))
+}
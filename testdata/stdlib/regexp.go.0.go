@@ -0,0 +1,21 @@
+//go:build examplemain
+// +build examplemain
+
+package main
+
+import (
+	"fmt"
+	"regexp"
+)
+
+var name = regexp.MustCompile(`[a-z+]+$`)
+
+var price = regexp.MustCompile("\\d+\\.\\d+")
+
+var literal = regexp.MustCompile(`a\+b\$`)
+
+func main() {
+	ok, err := regexp.MatchString("^ab+c", "abc")
+
+	fmt.Println(name, price, literal, ok, err)
+}
@@ -0,0 +1,30 @@
+//go:build examplemain
+// +build examplemain
+
+package main
+
+import (
+	"fmt"
+	"html"
+	"net/url"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	input := " <a href=\"/srv/../etc\"> "
+
+	trimmed := strings.TrimSpace(input)
+	query := "q=" + url.QueryEscape(trimmed)
+	path := filepath.Join("/srv", input)
+	page := map[string]string{"title": html.EscapeString(input)}
+
+	var upper = strings.ToUpper(strings.TrimSpace(input))
+
+	fmt.Println(query, path, page, upper, clean(input))
+	fmt.Println(html.UnescapeString(input), url.PathEscape(input))
+}
+
+func clean(p string) string {
+	return filepath.Clean(p)
+}
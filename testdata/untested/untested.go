@@ -0,0 +1,9 @@
+package untested
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+
+	return n
+}
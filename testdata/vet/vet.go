@@ -0,0 +1,5 @@
+package vet
+
+func oneOrTwo(n int) bool {
+	return n == 1 || n == 2
+}
@@ -0,0 +1,11 @@
+package vet
+
+import (
+	"testing"
+)
+
+func TestOneOrTwo(t *testing.T) {
+	if !oneOrTwo(1) || !oneOrTwo(2) || oneOrTwo(3) {
+		t.Fail()
+	}
+}
@@ -3,6 +3,7 @@ package mutesting
 import (
 	"fmt"
 	"go/ast"
+	"go/token"
 	"go/types"
 	"strings"
 
@@ -45,6 +46,10 @@ func (w *countWalk) Visit(node ast.Node) ast.Visitor {
 // MutateWalk mutates the given node with the given mutator returning a channel to control the mutation steps.
 // It traverses the AST of the given node and calls the method Check of the given mutator to verify that a node can be mutated by the mutator. If a node can be mutated the method Mutate of the given mutator is executed with the node and the control channel. After completion of the traversal the control channel is closed.
 func MutateWalk(pkg *types.Package, info *types.Info, node ast.Node, m mutator.Mutator) chan bool {
+	return newMutateWalk(pkg, info, node, m).changed
+}
+
+func newMutateWalk(pkg *types.Package, info *types.Info, node ast.Node, m mutator.Mutator) *mutateWalk {
 	w := &mutateWalk{
 		changed: make(chan bool),
 		mutator: m,
@@ -58,7 +63,7 @@ func MutateWalk(pkg *types.Package, info *types.Info, node ast.Node, m mutator.M
 		close(w.changed)
 	}()
 
-	return w.changed
+	return w
 }
 
 type mutateWalk struct {
@@ -66,6 +71,8 @@ type mutateWalk struct {
 	mutator mutator.Mutator
 	pkg     *types.Package
 	info    *types.Info
+	// pos is the position of the current mutation, it is read after the change was received from the channel
+	pos token.Pos
 }
 
 // Visit implements the Visit method of the ast.Visitor interface
@@ -75,6 +82,12 @@ func (w *mutateWalk) Visit(node ast.Node) ast.Visitor {
 	}
 
 	for _, m := range w.mutator(w.pkg, w.info, node) {
+		// The position is taken before the change since the mutated node can have new child nodes without positions
+		w.pos = m.Pos
+		if !w.pos.IsValid() {
+			w.pos = node.Pos()
+		}
+
 		m.Change()
 		w.changed <- true
 		<-w.changed
@@ -0,0 +1,56 @@
+package mutesting
+
+import (
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/VirtualRoyalty/go-mutesting/mutator"
+	_ "github.com/VirtualRoyalty/go-mutesting/mutator/arithmetic"
+	_ "github.com/VirtualRoyalty/go-mutesting/mutator/branch"
+	_ "github.com/VirtualRoyalty/go-mutesting/mutator/concurrency"
+	_ "github.com/VirtualRoyalty/go-mutesting/mutator/conditional"
+	_ "github.com/VirtualRoyalty/go-mutesting/mutator/embedding"
+	_ "github.com/VirtualRoyalty/go-mutesting/mutator/errors"
+	_ "github.com/VirtualRoyalty/go-mutesting/mutator/expression"
+	_ "github.com/VirtualRoyalty/go-mutesting/mutator/literals"
+	_ "github.com/VirtualRoyalty/go-mutesting/mutator/loop"
+	_ "github.com/VirtualRoyalty/go-mutesting/mutator/maps"
+	_ "github.com/VirtualRoyalty/go-mutesting/mutator/numbers"
+	_ "github.com/VirtualRoyalty/go-mutesting/mutator/slices"
+	_ "github.com/VirtualRoyalty/go-mutesting/mutator/statement"
+	_ "github.com/VirtualRoyalty/go-mutesting/mutator/stdlib"
+)
+
+func TestCountWalkWithoutTypeInfo(t *testing.T) {
+	files, err := filepath.Glob("testdata/*/*.go")
+	assert.NoError(t, err)
+
+	// The mutations of the test data are no source files of their own
+	mutation := regexp.MustCompile(`\.go\.\d+\.go$`)
+
+	for _, file := range files {
+		if mutation.MatchString(file) {
+			continue
+		}
+
+		src, err := parser.ParseFile(token.NewFileSet(), file, nil, parser.ParseComments)
+		if err != nil {
+			continue
+		}
+
+		for _, name := range mutator.List() {
+			m, err := mutator.New(name)
+			assert.NoError(t, err)
+
+			// Mutators which need type information do not mutate anything without it
+			assert.NotPanics(t, func() {
+				CountWalk(nil, nil, src, m)
+			}, "%s of %s", name, file)
+		}
+	}
+}
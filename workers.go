@@ -0,0 +1,451 @@
+package mutesting
+
+import (
+	"context"
+	"fmt"
+	"go/types"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/VirtualRoyalty/osutil"
+
+	"github.com/VirtualRoyalty/go-mutesting/internal/console"
+	"github.com/VirtualRoyalty/go-mutesting/internal/impact"
+	"github.com/VirtualRoyalty/go-mutesting/internal/models"
+	"github.com/VirtualRoyalty/go-mutesting/internal/testbin"
+)
+
+type mutantJob struct {
+	mutant       models.Mutant
+	pkg          *types.Package
+	originalFile string
+	mutationFile string
+	checksum     string
+	run          string
+	tests        []string
+}
+
+type mutantResult struct {
+	job          mutantJob
+	duplicate    bool
+	duplicateOf  models.Duplicate
+	notCovered   bool
+	noTests      bool
+	timeout      bool
+	vet          bool
+	execExitCode int
+	builtin      bool
+	diff         []byte
+	output       []byte
+	// err is the error which stopped the execution of the mutation
+	err error
+}
+
+// workerPool executes mutations concurrently and collects their results sequentially into the report.
+// Workers copy the modules into their own workspaces if the exec command replaces the original files.
+type workerPool struct {
+	ctx          context.Context
+	cancel       context.CancelFunc
+	opts         *models.Options
+	console      *console.Console
+	execs        []string
+	testBinaries *testbin.Runner
+	matrix       *models.KillMatrix
+	progress     *progressWriter
+	stream       *streamWriter
+	profiler     *engineProfiler
+	onMutant     func(mutant Mutant, verdict Verdict)
+
+	inPlace bool
+	jobs    chan mutantJob
+	results chan mutantResult
+
+	workers   sync.WaitGroup
+	collector sync.WaitGroup
+	done      sync.Once
+	// err is the first error of the executed mutations, the remaining mutations are not executed anymore
+	err error
+}
+
+func startWorkers(
+	ctx context.Context,
+	opts *models.Options,
+	c *console.Console,
+	files []string,
+	tmpDir string,
+	execs []string,
+	report *models.Report,
+	progress *progressWriter,
+	stream *streamWriter,
+	profiler *engineProfiler,
+	onMutant func(mutant Mutant, verdict Verdict),
+) (*workerPool, error) {
+	count := opts.Exec.Workers
+	if count < 1 {
+		count = 1
+	}
+
+	// The built-in exec command tests with an overlay and does not touch the original files, all other commands need copies of the modules
+	copies := count > 1 && (len(execs) > 0 || opts.Exec.BuildSystem != "")
+
+	if copies {
+		for _, file := range files {
+			module, err := moduleRoot(file)
+			if err != nil {
+				return nil, err
+			}
+
+			if buildSystem := buildSystems[opts.Exec.BuildSystem]; buildSystem != nil {
+				// Only the module is copied into the workspaces of the workers
+				root, err := buildSystem.root(file)
+				if err != nil {
+					return nil, err
+				}
+				if rel, err := filepath.Rel(module, root); err != nil || strings.HasPrefix(rel, "..") {
+					return nil, fmt.Errorf("the workspace %q of the build system is outside of the module %q, multiple workers need the workspace inside of the module", root, module)
+				}
+			}
+		}
+	}
+
+	if count > 1 && len(execs) > 0 && strings.ContainsRune(execs[0], filepath.Separator) {
+		// The exec command is executed inside of the workspaces
+		abs, err := filepath.Abs(execs[0])
+		if err != nil {
+			return nil, err
+		}
+
+		execs = append([]string{abs}, execs[1:]...)
+	}
+
+	// The execution is canceled by the first error of a mutation
+	ctx, cancel := context.WithCancel(ctx)
+
+	p := &workerPool{
+		ctx:      ctx,
+		cancel:   cancel,
+		opts:     opts,
+		console:  c,
+		execs:    execs,
+		progress: progress,
+		stream:   stream,
+		profiler: profiler,
+		onMutant: onMutant,
+		jobs:     make(chan mutantJob),
+		results:  make(chan mutantResult),
+	}
+
+	if opts.Test.Precompile {
+		p.testBinaries = testbin.NewRunner(goBinary(opts), opts.Files.Tags, func(cmd *exec.Cmd) {
+			limitResources(opts, cmd)
+		})
+	}
+	if opts.Report.ExportMatrix != "" {
+		p.matrix = models.NewKillMatrix()
+	}
+
+	// A single worker is executed synchronously by submit
+	p.inPlace = count == 1
+
+	for i := 0; i < count && !p.inPlace; i++ {
+		var ws *workspaces
+		if copies {
+			ws = &workspaces{
+				dir:   filepath.Join(tmpDir, fmt.Sprintf("worker-%d", i)),
+				roots: map[string]*workspace{},
+			}
+		}
+
+		p.workers.Add(1)
+		go p.work(ws)
+	}
+
+	p.collector.Add(1)
+	go func() {
+		defer p.collector.Done()
+
+		for result := range p.results {
+			if result.err != nil {
+				p.fail(result.err)
+
+				continue
+			}
+
+			mutant, verdict, err := collectResult(opts, c, report, result)
+			if err != nil {
+				p.fail(err)
+
+				continue
+			}
+
+			checksum := result.job.checksum
+			if result.duplicate {
+				checksum = result.duplicateOf.Checksum
+			}
+			p.stream.outcome(mutant, checksum, packagePathOf(result.job.pkg), verdict)
+
+			if p.onMutant != nil {
+				p.onMutant(mutant, verdict)
+			}
+			p.record(result)
+			p.progress.collect(report)
+		}
+	}()
+
+	return p, nil
+}
+
+func (p *workerPool) work(ws *workspaces) {
+	defer p.workers.Done()
+
+	for job := range p.jobs {
+		if ws == nil {
+			p.exec(job, nil)
+
+			continue
+		}
+
+		w, err := ws.of(p.console, job.originalFile)
+		if err != nil {
+			p.results <- mutantResult{
+				job: job,
+				err: fmt.Errorf("Could not create workspace of %q: %v", job.originalFile, err),
+			}
+
+			continue
+		}
+
+		p.exec(job, w)
+	}
+}
+
+func (p *workerPool) exec(job mutantJob, w *workspace) {
+	start := time.Now()
+	result, err := mutateExec(p.ctx, p.opts, p.console, job.pkg, job.originalFile, job.mutationFile, p.execs, &job.mutant, job.run, w, p.testBinaries)
+	result.job = job
+	result.err = err
+	p.profiler.tested(time.Since(start))
+
+	if p.ctx.Err() != nil {
+		// The tests of the mutation were possibly interrupted, so the result is not reliable
+		p.ignore()
+
+		return
+	}
+
+	p.stream.executed(job, time.Since(start))
+	p.results <- result
+}
+
+// submit executes the given mutation, it returns after the execution if the original files are replaced.
+func (p *workerPool) submit(job mutantJob) {
+	if p.inPlace {
+		p.exec(job, nil)
+
+		return
+	}
+
+	p.jobs <- job
+}
+
+// record records the executed and failed tests of a killed or escaped mutation of the built-in exec command in the kill matrix.
+func (p *workerPool) record(result mutantResult) {
+	if p.matrix == nil || !result.builtin || result.vet || (result.execExitCode != 0 && result.execExitCode != 1) {
+		return
+	}
+
+	var executed []string
+	for _, name := range result.job.tests {
+		executed = append(executed, result.job.pkg.Path()+"."+name)
+	}
+
+	var killed []string
+	if result.execExitCode == 0 {
+		for _, name := range impact.FailedTests(result.output) {
+			killed = append(killed, result.job.pkg.Path()+"."+name)
+		}
+	}
+
+	p.matrix.Add(result.job.checksum, result.job.mutant, executed, killed)
+}
+
+// duplicate records a duplicated mutation together with the mutation it duplicates.
+func (p *workerPool) duplicate(duplicate models.Duplicate, pkg *types.Package) {
+	p.results <- mutantResult{
+		job: mutantJob{
+			pkg:          pkg,
+			originalFile: duplicate.OriginalFilePath,
+		},
+		duplicate:   true,
+		duplicateOf: duplicate,
+	}
+}
+
+// notCovered records a mutation which is not covered by tests.
+func (p *workerPool) notCovered(job mutantJob) {
+	p.results <- mutantResult{
+		job:        job,
+		notCovered: true,
+	}
+}
+
+// noTests records a mutation of a package without test files as escaped without executing it.
+func (p *workerPool) noTests(job mutantJob) {
+	p.results <- mutantResult{
+		job:          job,
+		noTests:      true,
+		execExitCode: 1,
+		builtin:      true,
+		diff:         []byte(job.mutant.Diff),
+	}
+}
+
+// fail records the error of a mutation and cancels the execution of the remaining mutations, only the first error is kept.
+func (p *workerPool) fail(err error) {
+	if p.err == nil {
+		p.err = err
+	}
+
+	p.cancel()
+}
+
+// ignore records a mutation which is not executed.
+func (p *workerPool) ignore() {
+	p.progress.ignore()
+}
+
+// wait waits until all submitted mutations are executed and collected and returns the first error of the executed mutations.
+func (p *workerPool) wait() error {
+	p.done.Do(func() {
+		close(p.jobs)
+		p.workers.Wait()
+
+		close(p.results)
+		p.collector.Wait()
+
+		p.cancel()
+	})
+
+	return p.err
+}
+
+// workspaces holds the copies of modules of one worker.
+type workspaces struct {
+	dir   string
+	roots map[string]*workspace
+}
+
+// workspace is a copy of a module in which mutations can be executed without touching the original module.
+type workspace struct {
+	module string
+	root   string
+}
+
+// of returns the workspace of the module of the given file and copies the module if needed.
+func (ws *workspaces) of(c *console.Console, file string) (*workspace, error) {
+	module, err := moduleRoot(file)
+	if err != nil {
+		return nil, err
+	}
+
+	if w, ok := ws.roots[module]; ok {
+		return w, nil
+	}
+
+	w := &workspace{
+		module: module,
+		root:   filepath.Join(ws.dir, fmt.Sprintf("%d", len(ws.roots))),
+	}
+
+	c.Debug("Copy module %q into workspace %q", module, w.root)
+
+	err = copyModule(module, w.root)
+	if err != nil {
+		return nil, err
+	}
+
+	ws.roots[module] = w
+
+	return w, nil
+}
+
+// path returns the path of the given file inside of the workspace.
+func (w *workspace) path(file string) (string, error) {
+	rel, err := w.rel(file)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(w.root, rel), nil
+}
+
+// pkg returns the relative package pattern of the given file inside of the workspace.
+func (w *workspace) pkg(file string) (string, error) {
+	rel, err := w.rel(file)
+	if err != nil {
+		return "", err
+	}
+
+	return "./" + filepath.ToSlash(filepath.Dir(rel)), nil
+}
+
+func (w *workspace) rel(file string) (string, error) {
+	abs, err := filepath.Abs(file)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Rel(w.module, abs)
+}
+
+// moduleRoot returns the directory of the go.mod file of the given file.
+func moduleRoot(file string) (string, error) {
+	abs, err := filepath.Abs(file)
+	if err != nil {
+		return "", err
+	}
+
+	for dir := filepath.Dir(abs); ; dir = filepath.Dir(dir) {
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			return dir, nil
+		}
+
+		if parent := filepath.Dir(dir); parent == dir {
+			return "", fmt.Errorf("could not find a go.mod file for %q, multiple workers need a Go module", file)
+		}
+	}
+}
+
+// copyModule copies all files of the module into the given directory.
+func copyModule(module string, dir string) error {
+	return filepath.WalkDir(module, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(module, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dir, rel)
+
+		if d.IsDir() {
+			if d.Name() == ".git" {
+				return filepath.SkipDir
+			}
+
+			return os.MkdirAll(target, 0755)
+		}
+
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		return osutil.CopyFile(path, target)
+	})
+}